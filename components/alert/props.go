@@ -0,0 +1,34 @@
+package alert
+
+import "github.com/a-h/templ"
+
+// AlertProps defines the properties for the Alert component
+type AlertProps struct {
+	// Variant defines the visual style of the alert
+	// Options: "default", "destructive"
+	Variant string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// AlertTitleProps defines the properties for the AlertTitle component
+type AlertTitleProps struct {
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// AlertDescriptionProps defines the properties for the AlertDescription component
+type AlertDescriptionProps struct {
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
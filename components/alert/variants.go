@@ -0,0 +1,58 @@
+package alert
+
+import (
+	"github.com/coreycole/datastarui/utils"
+)
+
+// alertVariants generates the appropriate CSS classes for the alert based on variant
+// A leading svg child becomes the icon column
+func alertVariants(variant, className string) string {
+	// Base classes that are always applied - exact copy from New York v4
+	baseClasses := "relative w-full rounded-lg border px-4 py-3 text-sm grid has-[>svg]:grid-cols-[calc(var(--spacing)*4)_1fr] grid-cols-[0_1fr] has-[>svg]:gap-x-3 gap-y-0.5 items-start [&>svg]:size-4 [&>svg]:translate-y-0.5 [&>svg]:text-current"
+
+	// Variant classes - exact copy from New York v4
+	variantClasses := map[string]string{
+		"default":     "bg-card text-card-foreground",
+		"destructive": "text-destructive bg-card [&>svg]:text-current *:data-[slot=alert-description]:text-destructive/90",
+	}
+
+	// Set defaults if not provided
+	if variant == "" {
+		variant = "default"
+	}
+
+	// Combine all classes
+	classes := []string{baseClasses}
+	if variantClass := variantClasses[variant]; variantClass != "" {
+		classes = append(classes, variantClass)
+	}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
+
+// alertTitleVariants generates the CSS classes for the AlertTitle component
+func alertTitleVariants(className string) string {
+	baseClasses := "col-start-2 line-clamp-1 min-h-4 font-medium tracking-tight"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
+
+// alertDescriptionVariants generates the CSS classes for the AlertDescription component
+func alertDescriptionVariants(className string) string {
+	baseClasses := "text-muted-foreground col-start-2 grid justify-items-start gap-1 text-sm [&_p]:leading-relaxed"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
@@ -0,0 +1,39 @@
+package avatar
+
+import "github.com/a-h/templ"
+
+// AvatarProps defines the properties for the Avatar root component
+type AvatarProps struct {
+	// Class allows additional CSS classes to be added (e.g. size-10 for a larger avatar)
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// AvatarImageProps defines the properties for the AvatarImage component
+type AvatarImageProps struct {
+	// Src is the image URL
+	Src string
+
+	// Alt is the image's alternative text
+	Alt string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// AvatarFallbackProps defines the properties for the AvatarFallback component
+type AvatarFallbackProps struct {
+	// Name renders its initials when set; otherwise the children are shown
+	Name string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
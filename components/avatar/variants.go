@@ -0,0 +1,44 @@
+package avatar
+
+import (
+	"github.com/coreycole/datastarui/utils"
+)
+
+// avatarVariants generates the CSS classes for the Avatar root component
+func avatarVariants(className string) string {
+	// Base classes from New York v4
+	baseClasses := "relative flex size-8 shrink-0 overflow-hidden rounded-full"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
+
+// avatarImageVariants generates the CSS classes for the AvatarImage component
+// The image is stacked above the fallback, which shows through while loading
+func avatarImageVariants(className string) string {
+	baseClasses := "absolute inset-0 z-10 aspect-square size-full object-cover"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
+
+// avatarFallbackVariants generates the CSS classes for the AvatarFallback component
+func avatarFallbackVariants(className string) string {
+	// Base classes from New York v4
+	baseClasses := "bg-muted flex size-full items-center justify-center rounded-full text-sm"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
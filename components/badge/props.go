@@ -0,0 +1,22 @@
+package badge
+
+import "github.com/a-h/templ"
+
+// BadgeProps defines the properties for the Badge component
+type BadgeProps struct {
+	// Variant defines the visual style of the badge
+	// Options: "default", "secondary", "destructive", "outline"
+	Variant string
+
+	// AsChild renders the badge as a link to Href instead of a span
+	AsChild bool
+
+	// Href is the link destination when AsChild is true
+	Href string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
@@ -0,0 +1,37 @@
+package badge
+
+import (
+	"github.com/coreycole/datastarui/utils"
+)
+
+// BadgeVariants generates the appropriate CSS classes for the badge based on variant
+// Exported so other components can style their own elements as badges
+func BadgeVariants(variant, className string) string {
+	// Base classes that are always applied - exact copy from New York v4
+	baseClasses := "inline-flex items-center justify-center rounded-md border px-2 py-0.5 text-xs font-medium w-fit whitespace-nowrap shrink-0 [&>svg]:size-3 gap-1 [&>svg]:pointer-events-none focus-visible:border-ring focus-visible:ring-ring/50 focus-visible:ring-[3px] aria-invalid:ring-destructive/20 dark:aria-invalid:ring-destructive/40 aria-invalid:border-destructive transition-[color,box-shadow] overflow-hidden"
+
+	// Variant classes - exact copy from New York v4
+	variantClasses := map[string]string{
+		"default":     "border-transparent bg-primary text-primary-foreground [a&]:hover:bg-primary/90",
+		"secondary":   "border-transparent bg-secondary text-secondary-foreground [a&]:hover:bg-secondary/90",
+		"destructive": "border-transparent bg-destructive text-white [a&]:hover:bg-destructive/90 focus-visible:ring-destructive/20 dark:focus-visible:ring-destructive/40 dark:bg-destructive/60",
+		"outline":     "text-foreground [a&]:hover:bg-accent [a&]:hover:text-accent-foreground",
+	}
+
+	// Set defaults if not provided
+	if variant == "" {
+		variant = "default"
+	}
+
+	// Combine all classes
+	classes := []string{baseClasses}
+	if variantClass := variantClasses[variant]; variantClass != "" {
+		classes = append(classes, variantClass)
+	}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	// Use the utility function to merge classes (similar to cn() in shadcn/ui)
+	return utils.TwMerge(classes...)
+}
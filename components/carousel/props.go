@@ -0,0 +1,91 @@
+package carousel
+
+import "github.com/a-h/templ"
+
+// CarouselProps defines the properties for the Carousel component
+type CarouselProps struct {
+	// ID is a unique identifier for this carousel (required for the subcomponents and server updates)
+	ID string
+
+	// Orientation sets the scroll axis: "horizontal" (default) or "vertical"
+	Orientation string
+
+	// Loop wraps Previous/Next around at either end
+	Loop bool
+
+	// Autoplay advances the carousel on an interval, pausing while hovered or focused
+	Autoplay bool
+
+	// Interval sets the autoplay delay in milliseconds (default 4000)
+	Interval int
+
+	// LoadMoreURL is fetched with @get when the end is reached; the handler appends slides
+	// to TrackID and answers with LoadedUpdate. The current slide count is sent as ?offset=
+	LoadMoreURL string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// CarouselContentProps defines the properties for the CarouselContent component
+type CarouselContentProps struct {
+	// ID must match the parent Carousel ID
+	ID string
+
+	// ViewportClass adds classes to the scrolling viewport (e.g. a height for vertical carousels)
+	ViewportClass string
+
+	// Class allows additional CSS classes to be added to the track holding the items
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// CarouselItemProps defines the properties for the CarouselItem component
+type CarouselItemProps struct {
+	// Class allows additional CSS classes to be added (e.g. "basis-1/3" for several slides per view)
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// CarouselPreviousProps defines the properties for the CarouselPrevious component
+type CarouselPreviousProps struct {
+	// ID must match the parent Carousel ID
+	ID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// CarouselNextProps defines the properties for the CarouselNext component
+type CarouselNextProps struct {
+	// ID must match the parent Carousel ID
+	ID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// CarouselDotsProps defines the properties for the CarouselDots component
+type CarouselDotsProps struct {
+	// ID must match the parent Carousel ID
+	ID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
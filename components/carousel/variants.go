@@ -0,0 +1,65 @@
+package carousel
+
+import "github.com/coreycole/datastarui/utils"
+
+// carouselVariants generates the CSS classes for the carousel root
+func carouselVariants(className string) string {
+	return utils.TwMerge("relative", className)
+}
+
+// carouselViewportVariants generates the CSS classes for the scroll-snapping viewport
+func carouselViewportVariants(className string) string {
+	return utils.TwMerge(
+		"no-scrollbar relative snap-x snap-mandatory overflow-x-auto overflow-y-hidden overscroll-x-contain "+
+			"[[data-orientation=vertical]_&]:snap-y [[data-orientation=vertical]_&]:overflow-x-hidden "+
+			"[[data-orientation=vertical]_&]:overflow-y-auto [[data-orientation=vertical]_&]:overscroll-y-contain",
+		className,
+	)
+}
+
+// carouselTrackVariants generates the CSS classes for the track holding the items
+// The track overhangs the viewport by one gap so every snapped item's trailing padding is clipped
+func carouselTrackVariants(className string) string {
+	return utils.TwMerge(
+		"-mr-4 flex [[data-orientation=vertical]_&]:mr-0 [[data-orientation=vertical]_&]:h-[calc(100%+1rem)] [[data-orientation=vertical]_&]:flex-col",
+		className,
+	)
+}
+
+// carouselItemVariants generates the CSS classes for a slide
+func carouselItemVariants(className string) string {
+	return utils.TwMerge(
+		"min-w-0 shrink-0 grow-0 basis-full snap-start pr-4 "+
+			"[[data-orientation=vertical]_&]:pr-0 [[data-orientation=vertical]_&]:pb-4",
+		className,
+	)
+}
+
+// carouselPreviousVariants generates the CSS classes for the previous button
+func carouselPreviousVariants(className string) string {
+	return utils.TwMerge(
+		"absolute size-8 rounded-full top-1/2 -left-12 -translate-y-1/2 "+
+			"[[data-orientation=vertical]_&]:-top-12 [[data-orientation=vertical]_&]:left-1/2 "+
+			"[[data-orientation=vertical]_&]:-translate-x-1/2 [[data-orientation=vertical]_&]:translate-y-0 [[data-orientation=vertical]_&]:rotate-90",
+		className,
+	)
+}
+
+// carouselNextVariants generates the CSS classes for the next button
+func carouselNextVariants(className string) string {
+	return utils.TwMerge(
+		"absolute size-8 rounded-full top-1/2 -right-12 -translate-y-1/2 "+
+			"[[data-orientation=vertical]_&]:top-auto [[data-orientation=vertical]_&]:-bottom-12 [[data-orientation=vertical]_&]:right-auto [[data-orientation=vertical]_&]:left-1/2 "+
+			"[[data-orientation=vertical]_&]:-translate-x-1/2 [[data-orientation=vertical]_&]:translate-y-0 [[data-orientation=vertical]_&]:rotate-90",
+		className,
+	)
+}
+
+// carouselDotsVariants generates the CSS classes for the dot indicator row
+func carouselDotsVariants(className string) string {
+	return utils.TwMerge("flex items-center justify-center gap-2 pt-4", className)
+}
+
+// carouselDotClass is applied to the dots, which are created in the browser as slides are counted
+const carouselDotClass = "size-2 rounded-full bg-primary/20 transition-colors hover:bg-primary/40 " +
+	"focus-visible:outline-none focus-visible:ring-[3px] focus-visible:ring-ring/50 data-[state=active]:bg-primary"
@@ -0,0 +1,57 @@
+package chart
+
+import "github.com/a-h/templ"
+
+// Series is one named set of values, one value per chart label
+type Series struct {
+	// Label names the series in the legend, tooltips and data table
+	Label string
+
+	// Values holds one value per label
+	Values []float64
+
+	// Color is any CSS color (default: the theme's --chart-N color for the series position)
+	Color string
+}
+
+// ChartProps defines the properties shared by all chart components
+type ChartProps struct {
+	// ID is the element ID; re-render the chart with the same ID as an SSE fragment to update it
+	ID string
+
+	// Title is the accessible name of the chart and the caption of its data table
+	Title string
+
+	// Labels are the categories along the x axis, or the slices/rings of pie and radial charts
+	Labels []string
+
+	// Series holds the plotted values; pie and radial charts use the first series only
+	Series []Series
+
+	// Height sets the drawing height in SVG units; the width is always 600 (default 300, 250 for pie/radial)
+	Height int
+
+	// Stacked stacks the series of bar and area charts instead of grouping/overlapping them
+	Stacked bool
+
+	// Donut cuts out the center of a pie chart and shows the total there
+	Donut bool
+
+	// Max sets the value of a full ring in radial charts (default: the largest value)
+	Max float64
+
+	// HideLegend hides the legend below the chart
+	HideLegend bool
+
+	// HideGrid hides the horizontal grid lines and y axis labels of cartesian charts
+	HideGrid bool
+
+	// Format formats values for axis labels, tooltips and the data table (default: up to 2 decimals)
+	Format func(float64) string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
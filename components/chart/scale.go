@@ -0,0 +1,371 @@
+package chart
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Drawing geometry in SVG units; charts scale to the width of their container
+const (
+	viewWidth     = 600
+	marginTop     = 12
+	marginRight   = 12
+	marginBottom  = 28
+	marginLeft    = 48
+	tooltipWidth  = 180
+	maxAxisLabels = 12
+)
+
+// Color returns the theme color for the series or slice at index i, cycling through --chart-1..5
+func Color(i int) string {
+	return fmt.Sprintf("hsl(var(--chart-%d))", i%5+1)
+}
+
+// seriesColor returns the series' own color or the theme color for its position
+func seriesColor(s Series, i int) string {
+	if s.Color != "" {
+		return s.Color
+	}
+	return Color(i)
+}
+
+// value returns the series value for label i, treating missing values as zero
+func (s Series) value(i int) float64 {
+	if i < len(s.Values) {
+		return s.Values[i]
+	}
+	return 0
+}
+
+// formatValue is the default value format: at most two decimals, no trailing zeros
+func formatValue(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
+
+// format applies the chart's value format
+func (p ChartProps) format(v float64) string {
+	if p.Format != nil {
+		return p.Format(v)
+	}
+	return formatValue(v)
+}
+
+// coord formats an SVG coordinate
+func coord(v float64) string {
+	return strconv.FormatFloat(v, 'f', 2, 64)
+}
+
+// height returns the drawing height, falling back to def
+func (p ChartProps) height(def int) float64 {
+	if p.Height > 0 {
+		return float64(p.Height)
+	}
+	return float64(def)
+}
+
+// niceNum rounds x to 1, 2, 5 or 10 times a power of ten so axis ticks land on readable values
+func niceNum(x float64, round bool) float64 {
+	if x <= 0 {
+		return 1
+	}
+	exp := math.Floor(math.Log10(x))
+	f := x / math.Pow(10, exp)
+	var nf float64
+	switch {
+	case round && f < 1.5, !round && f <= 1:
+		nf = 1
+	case round && f < 3, !round && f <= 2:
+		nf = 2
+	case round && f < 7, !round && f <= 5:
+		nf = 5
+	default:
+		nf = 10
+	}
+	return nf * math.Pow(10, exp)
+}
+
+// plot holds the geometry of a cartesian (bar, line, area) chart
+type plot struct {
+	top, right, bottom, left float64
+	min, max                 float64
+	ticks                    []float64
+	count                    int // number of labels
+}
+
+// newPlot fits a value axis including zero and [lo, hi] into a drawing of the given height
+func newPlot(height, lo, hi float64, count int) plot {
+	lo, hi = math.Min(lo, 0), math.Max(hi, 0)
+	if hi == lo {
+		hi = lo + 1
+	}
+	step := niceNum(niceNum(hi-lo, false)/4, true)
+	p := plot{
+		top:    marginTop,
+		right:  viewWidth - marginRight,
+		bottom: height - marginBottom,
+		left:   marginLeft,
+		min:    math.Floor(lo/step) * step,
+		max:    math.Ceil(hi/step) * step,
+		count:  max(count, 1),
+	}
+	for k := 0.0; p.min+k*step <= p.max+step/2; k++ {
+		p.ticks = append(p.ticks, p.min+k*step)
+	}
+	return p
+}
+
+// y maps a value to its SVG y coordinate
+func (p plot) y(v float64) float64 {
+	return p.bottom - (v-p.min)/(p.max-p.min)*(p.bottom-p.top)
+}
+
+// band returns the width of each label's slot along the x axis
+func (p plot) band() float64 {
+	return (p.right - p.left) / float64(p.count)
+}
+
+// x returns the center of label i's slot
+func (p plot) x(i int) float64 {
+	return p.left + p.band()*(float64(i)+0.5)
+}
+
+// axisLabel reports whether label i is drawn, thinning labels so at most maxAxisLabels show
+func (p plot) axisLabel(i int) bool {
+	return i%int(math.Ceil(float64(p.count)/maxAxisLabels)) == 0
+}
+
+// tooltipLeft positions a tooltip beside the point at x, or to its left when flipped
+func tooltipLeft(x float64, flip bool) float64 {
+	if flip {
+		return x - 8 - tooltipWidth
+	}
+	return x + 8
+}
+
+// valueRange returns the smallest and largest plotted value; stacked charts use the stacked totals
+func valueRange(props ChartProps, stacked bool) (lo, hi float64) {
+	for i := range props.Labels {
+		var pos, neg float64
+		for _, s := range props.Series {
+			v := s.value(i)
+			if stacked {
+				if v >= 0 {
+					pos += v
+				} else {
+					neg += v
+				}
+				continue
+			}
+			lo, hi = math.Min(lo, v), math.Max(hi, v)
+		}
+		if stacked {
+			lo, hi = math.Min(lo, neg), math.Max(hi, pos)
+		}
+	}
+	return lo, hi
+}
+
+// stackBase returns, per series and label, the value each stacked segment starts from
+func stackBase(props ChartProps) [][]float64 {
+	base := make([][]float64, len(props.Series))
+	pos := make([]float64, len(props.Labels))
+	neg := make([]float64, len(props.Labels))
+	for si, s := range props.Series {
+		base[si] = make([]float64, len(props.Labels))
+		for i := range props.Labels {
+			v := s.value(i)
+			if v >= 0 {
+				base[si][i] = pos[i]
+				pos[i] += v
+			} else {
+				base[si][i] = neg[i]
+				neg[i] += v
+			}
+		}
+	}
+	return base
+}
+
+// bar is one rectangle of a bar chart
+type bar struct {
+	X, Y, W, H float64
+	Color      string
+}
+
+// bars lays out grouped or stacked bars, one slice of bars per series
+func bars(props ChartProps, p plot) [][]bar {
+	band := p.band()
+	inner := band * 0.8
+	var base [][]float64
+	if props.Stacked {
+		base = stackBase(props)
+	}
+	out := make([][]bar, len(props.Series))
+	for si, s := range props.Series {
+		for i := range props.Labels {
+			from, to := 0.0, s.value(i)
+			x, w := p.x(i)-inner/2, inner
+			if props.Stacked {
+				from, to = base[si][i], base[si][i]+s.value(i)
+			} else {
+				w = inner / float64(len(props.Series))
+				x += w * float64(si)
+			}
+			y0, y1 := p.y(from), p.y(to)
+			out[si] = append(out[si], bar{
+				X:     x + 1,
+				Y:     math.Min(y0, y1),
+				W:     math.Max(w-2, 1),
+				H:     math.Abs(y0 - y1),
+				Color: seriesColor(s, si),
+			})
+		}
+	}
+	return out
+}
+
+// linePoints returns the points of series si, stacked on the previous series when requested
+func linePoints(props ChartProps, p plot, si int, base [][]float64) [][2]float64 {
+	points := make([][2]float64, len(props.Labels))
+	for i := range props.Labels {
+		v := props.Series[si].value(i)
+		if base != nil {
+			v += base[si][i]
+		}
+		points[i] = [2]float64{p.x(i), p.y(v)}
+	}
+	return points
+}
+
+// linePath returns an SVG path through the points
+func linePath(points [][2]float64) string {
+	var b strings.Builder
+	for i, pt := range points {
+		if i == 0 {
+			b.WriteString("M")
+		} else {
+			b.WriteString(" L")
+		}
+		b.WriteString(coord(pt[0]) + " " + coord(pt[1]))
+	}
+	return b.String()
+}
+
+// areaPath returns an SVG path filling between the points and the baseline points (walked backwards)
+func areaPath(points, baseline [][2]float64) string {
+	if len(points) == 0 {
+		return ""
+	}
+	path := linePath(points)
+	for i := len(baseline) - 1; i >= 0; i-- {
+		path += " L" + coord(baseline[i][0]) + " " + coord(baseline[i][1])
+	}
+	return path + " Z"
+}
+
+// arcPath returns an SVG path for a ring segment between the angles (radians, clockwise from 12 o'clock)
+// An inner radius of zero draws a pie slice
+func arcPath(cx, cy, r, inner, start, end float64) string {
+	// A full circle cannot be drawn as a single arc
+	end = math.Min(end, start+2*math.Pi-1e-4)
+	large := "0"
+	if end-start > math.Pi {
+		large = "1"
+	}
+	point := func(radius, angle float64) string {
+		return coord(cx+radius*math.Sin(angle)) + " " + coord(cy-radius*math.Cos(angle))
+	}
+	r0 := coord(r)
+	path := "M" + point(r, start) + " A" + r0 + " " + r0 + " 0 " + large + " 1 " + point(r, end)
+	if inner <= 0 {
+		return path + " L" + coord(cx) + " " + coord(cy) + " Z"
+	}
+	ri := coord(inner)
+	return path + " L" + point(inner, end) + " A" + ri + " " + ri + " 0 " + large + " 0 " + point(inner, start) + " Z"
+}
+
+// slice is one segment of a pie chart
+type slice struct {
+	Path    string
+	Color   string
+	Label   string
+	Value   float64
+	Percent float64
+	Mid     float64 // middle angle, used to place the tooltip
+}
+
+// slices lays out the first series as pie slices around (cx, cy)
+func slices(props ChartProps, cx, cy, r, inner float64) []slice {
+	if len(props.Series) == 0 {
+		return nil
+	}
+	var total float64
+	for i := range props.Labels {
+		total += math.Max(props.Series[0].value(i), 0)
+	}
+	if total == 0 {
+		return nil
+	}
+	var out []slice
+	angle := 0.0
+	for i, label := range props.Labels {
+		v := math.Max(props.Series[0].value(i), 0)
+		sweep := v / total * 2 * math.Pi
+		out = append(out, slice{
+			Path:    arcPath(cx, cy, r, inner, angle, angle+sweep),
+			Color:   Color(i),
+			Label:   label,
+			Value:   v,
+			Percent: v / total * 100,
+			Mid:     angle + sweep/2,
+		})
+		angle += sweep
+	}
+	return out
+}
+
+// ring is one track of a radial chart
+type ring struct {
+	R      float64
+	Dash   string // stroke-dasharray drawing the filled part of the circle
+	Color  string
+	Label  string
+	Value  float64
+	Stroke float64
+}
+
+// rings lays out the first series as concentric rings around the center, outermost first
+func rings(props ChartProps, outer float64) []ring {
+	if len(props.Series) == 0 || len(props.Labels) == 0 {
+		return nil
+	}
+	full := props.Max
+	if full <= 0 {
+		for i := range props.Labels {
+			full = math.Max(full, props.Series[0].value(i))
+		}
+	}
+	if full <= 0 {
+		full = 1
+	}
+	step := outer * 0.7 / float64(len(props.Labels))
+	stroke := step * 0.75
+	var out []ring
+	for i, label := range props.Labels {
+		r := outer - step*float64(i) - stroke/2
+		v := props.Series[0].value(i)
+		c := 2 * math.Pi * r
+		filled := math.Min(math.Max(v/full, 0), 1) * c
+		out = append(out, ring{
+			R:      r,
+			Dash:   coord(filled) + " " + coord(c),
+			Color:  Color(i),
+			Label:  label,
+			Value:  v,
+			Stroke: stroke,
+		})
+	}
+	return out
+}
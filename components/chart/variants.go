@@ -0,0 +1,18 @@
+package chart
+
+import "github.com/coreycole/datastarui/utils"
+
+// chartVariants generates the CSS classes for the chart figure
+func chartVariants(className string) string {
+	return utils.TwMerge("flex w-full flex-col gap-4 text-xs", className)
+}
+
+// chartTooltipVariants generates the CSS classes for the hover tooltip
+func chartTooltipVariants() string {
+	return "bg-background text-foreground border-border/50 grid min-w-32 gap-1.5 rounded-lg border px-2.5 py-1.5 text-xs shadow-xl"
+}
+
+// chartLegendVariants generates the CSS classes for the legend row
+func chartLegendVariants() string {
+	return "flex flex-wrap items-center justify-center gap-4"
+}
@@ -0,0 +1,61 @@
+package collapsible
+
+import "github.com/a-h/templ"
+
+// CollapsibleProps defines the properties for the Collapsible root component
+type CollapsibleProps struct {
+	// ID is a unique identifier for this collapsible (required for signal management)
+	ID string
+
+	// DefaultOpen sets the initial open state
+	DefaultOpen bool
+
+	// Signal binds the open state to an existing boolean signal (e.g. "$settings.advanced")
+	// for controlled usage; the collapsible then declares no signal of its own
+	Signal string
+
+	// Disabled prevents the trigger from toggling the content
+	Disabled bool
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// CollapsibleTriggerProps defines the properties for the CollapsibleTrigger component
+type CollapsibleTriggerProps struct {
+	// ID is the collapsible identifier (required to link to the correct collapsible)
+	ID string
+
+	// Signal must match the Collapsible Signal in controlled mode
+	Signal string
+
+	// Disabled prevents the trigger from toggling the content
+	Disabled bool
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// CollapsibleContentProps defines the properties for the CollapsibleContent component
+type CollapsibleContentProps struct {
+	// ID is the collapsible identifier (required to link to the correct collapsible)
+	ID string
+
+	// Signal must match the Collapsible Signal in controlled mode
+	Signal string
+
+	// DefaultOpen must match the Collapsible DefaultOpen so the first paint has the right state
+	DefaultOpen bool
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
@@ -0,0 +1,23 @@
+package collapsible
+
+import (
+	"github.com/coreycole/datastarui/utils"
+)
+
+// collapsibleContentVariants generates the CSS classes for the CollapsibleContent wrapper
+// Animates by transitioning grid rows between 0fr and 1fr, driven by data-state
+func collapsibleContentVariants(className string) string {
+	baseClasses := "grid transition-[grid-template-rows] duration-200 ease-out data-[state=closed]:grid-rows-[0fr] data-[state=open]:grid-rows-[1fr]"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
+
+// collapsibleContentInnerVariants generates the CSS classes for the clipped inner element
+func collapsibleContentInnerVariants() string {
+	return "min-h-0 overflow-hidden"
+}
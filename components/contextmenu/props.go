@@ -0,0 +1,235 @@
+package contextmenu
+
+import "github.com/a-h/templ"
+
+// ContextMenuProps defines the properties for the ContextMenu root component
+type ContextMenuProps struct {
+	// ID provides a unique identifier for the context menu (auto-generated if not provided)
+	ID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// ContextMenuTriggerProps defines the properties for the ContextMenuTrigger area
+type ContextMenuTriggerProps struct {
+	// ID is the context menu identifier (required to link to the correct menu)
+	ID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+
+	// Disabled lets the native browser context menu show instead
+	Disabled bool
+
+	// LongPressDelay is the touch hold duration in milliseconds before opening (default: 500)
+	LongPressDelay int
+}
+
+// ContextMenuContentProps defines the properties for the ContextMenuContent panel
+type ContextMenuContentProps struct {
+	// ID is the context menu identifier (required to link to the correct menu)
+	ID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// ContextMenuItemProps defines the properties for the ContextMenuItem component
+type ContextMenuItemProps struct {
+	// ID is the context menu identifier (required to link to the correct menu)
+	ID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+
+	// Inset adds left padding for nested items
+	Inset bool
+
+	// Variant defines the visual style of the item
+	// Options: "default", "destructive"
+	Variant string
+
+	// Disabled makes the item non-interactive
+	Disabled bool
+}
+
+// ContextMenuCheckboxItemProps defines the properties for the ContextMenuCheckboxItem component
+type ContextMenuCheckboxItemProps struct {
+	// ID is the context menu identifier (required to link to the correct menu)
+	ID string
+
+	// Name is the signal key the checked state is stored under ($<ID>.<Name>)
+	Name string
+
+	// Checked sets the initial checked state
+	Checked bool
+
+	// KeepOpen leaves the menu open after toggling
+	KeepOpen bool
+
+	// Disabled makes the item non-interactive
+	Disabled bool
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// ContextMenuRadioGroupProps defines the properties for the ContextMenuRadioGroup component
+type ContextMenuRadioGroupProps struct {
+	// ID is the context menu identifier (required to link to the correct menu)
+	ID string
+
+	// Name is the signal key the selected value is stored under ($<ID>.<Name>)
+	Name string
+
+	// Value sets the initially selected value
+	Value string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// ContextMenuRadioItemProps defines the properties for the ContextMenuRadioItem component
+type ContextMenuRadioItemProps struct {
+	// ID is the context menu identifier (required to link to the correct menu)
+	ID string
+
+	// Name must match the parent ContextMenuRadioGroup Name
+	Name string
+
+	// Value is the value selected by this item
+	Value string
+
+	// KeepOpen leaves the menu open after selecting
+	KeepOpen bool
+
+	// Disabled makes the item non-interactive
+	Disabled bool
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// ContextMenuLabelProps defines the properties for the ContextMenuLabel component
+type ContextMenuLabelProps struct {
+	// ID is the context menu identifier (optional for labels)
+	ID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+
+	// Inset adds left padding for nested labels
+	Inset bool
+}
+
+// ContextMenuSeparatorProps defines the properties for the ContextMenuSeparator component
+type ContextMenuSeparatorProps struct {
+	// ID is the context menu identifier (optional for separators)
+	ID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// ContextMenuShortcutProps defines the properties for the ContextMenuShortcut component
+type ContextMenuShortcutProps struct {
+	// ID is the context menu identifier (optional for shortcuts)
+	ID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// ContextMenuGroupProps defines the properties for the ContextMenuGroup component
+type ContextMenuGroupProps struct {
+	// ID is the context menu identifier (optional for groups)
+	ID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// ContextMenuSubProps defines the properties for the ContextMenuSub wrapper
+type ContextMenuSubProps struct {
+	// ID is the context menu identifier (required to link to the correct menu)
+	ID string
+
+	// SubID identifies this submenu (must be unique within the menu)
+	SubID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// ContextMenuSubTriggerProps defines the properties for the ContextMenuSubTrigger component
+type ContextMenuSubTriggerProps struct {
+	// ID is the context menu identifier (required to link to the correct menu)
+	ID string
+
+	// SubID must match the parent ContextMenuSub SubID
+	SubID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+
+	// Inset adds left padding for nested items
+	Inset bool
+
+	// Disabled makes the trigger non-interactive
+	Disabled bool
+}
+
+// ContextMenuSubContentProps defines the properties for the ContextMenuSubContent panel
+type ContextMenuSubContentProps struct {
+	// ID is the context menu identifier (required to link to the correct menu)
+	ID string
+
+	// SubID must match the parent ContextMenuSub SubID
+	SubID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
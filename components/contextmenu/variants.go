@@ -0,0 +1,66 @@
+package contextmenu
+
+import (
+	"github.com/coreycole/datastarui/components/dropdown"
+	"github.com/coreycole/datastarui/utils"
+)
+
+// contextMenuTriggerVariants generates CSS classes for the ContextMenuTrigger area
+func contextMenuTriggerVariants(className string) string {
+	// No specific base classes for the trigger in shadcn/ui context menu
+	classes := []string{}
+
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
+
+// contextMenuContentVariants generates CSS classes for the ContextMenuContent panel
+func contextMenuContentVariants(className string) string {
+	// Same surface as the dropdown content, plus scrolling for long menus near the viewport edge
+	return dropdown.DropdownMenuContentVariants(utils.TwMerge("max-h-[calc(100vh-16px)] overflow-x-hidden overflow-y-auto", className))
+}
+
+// contextMenuSubTriggerVariants generates CSS classes for the ContextMenuSubTrigger component
+func contextMenuSubTriggerVariants(className string, inset bool) string {
+	// Extract EXACT base classes from shadcn/ui context menu
+	baseClasses := "focus:bg-accent focus:text-accent-foreground data-[state=open]:bg-accent data-[state=open]:text-accent-foreground flex cursor-default items-center rounded-sm px-2 py-1.5 text-sm outline-hidden select-none data-[disabled]:pointer-events-none data-[disabled]:opacity-50 [&_svg]:pointer-events-none [&_svg]:shrink-0 [&_svg:not([class*='size-'])]:size-4"
+
+	classes := []string{baseClasses}
+	if inset {
+		classes = append(classes, "data-[inset]:pl-8")
+	}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
+
+// contextMenuSubContentVariants generates CSS classes for the ContextMenuSubContent panel
+func contextMenuSubContentVariants(className string) string {
+	// Extract EXACT base classes from shadcn/ui context menu, anchored beside the sub trigger
+	baseClasses := "bg-popover text-popover-foreground data-[state=open]:animate-in data-[state=closed]:animate-out data-[state=closed]:fade-out-0 data-[state=open]:fade-in-0 data-[state=closed]:zoom-out-95 data-[state=open]:zoom-in-95 z-50 min-w-[8rem] overflow-hidden rounded-md border p-1 shadow-lg -mt-1"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
+
+// contextMenuCheckableItemVariants generates CSS classes for checkbox and radio items
+func contextMenuCheckableItemVariants(className string) string {
+	// Extract EXACT base classes from shadcn/ui context menu
+	baseClasses := "focus:bg-accent focus:text-accent-foreground relative flex cursor-default items-center gap-2 rounded-sm py-1.5 pr-2 pl-8 text-sm outline-hidden select-none data-[disabled]:pointer-events-none data-[disabled]:opacity-50 [&_svg]:pointer-events-none [&_svg]:shrink-0 [&_svg:not([class*='size-'])]:size-4"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
@@ -5,7 +5,8 @@ import "github.com/a-h/templ"
 // DialogProps defines the props for the Dialog container (using Datastar signals)
 type DialogProps struct {
 	ID          string
-	DefaultOpen bool // Whether the dialog should be open by default
+	DefaultOpen bool   // Whether the dialog should be open by default
+	Role        string // ARIA role of the dialog (default: "dialog"), e.g. "alertdialog" for confirmations
 	Class       string
 	Attributes  templ.Attributes
 }
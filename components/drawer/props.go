@@ -0,0 +1,53 @@
+package drawer
+
+import "github.com/a-h/templ"
+
+// DrawerProps defines the props for the Drawer container (using Datastar signals)
+type DrawerProps struct {
+	ID              string
+	DefaultOpen     bool      // Whether the drawer should be open by default
+	SnapPoints      []float64 // Optional heights as fractions of the viewport, smallest first (e.g. 0.4, 1)
+	ScaleBackground bool      // Scale down the element marked data-drawer-wrapper while open
+	Class           string
+	Attributes      templ.Attributes
+}
+
+// DrawerTriggerProps defines the props for the DrawerTrigger component
+type DrawerTriggerProps struct {
+	DrawerID   string
+	AsChild    bool
+	Class      string
+	Attributes templ.Attributes
+}
+
+// DrawerHeaderProps defines the props for the DrawerHeader component
+type DrawerHeaderProps struct {
+	Class      string
+	Attributes templ.Attributes
+}
+
+// DrawerFooterProps defines the props for the DrawerFooter component
+type DrawerFooterProps struct {
+	Class      string
+	Attributes templ.Attributes
+}
+
+// DrawerTitleProps defines the props for the DrawerTitle component
+type DrawerTitleProps struct {
+	Class      string
+	Attributes templ.Attributes
+}
+
+// DrawerDescriptionProps defines the props for the DrawerDescription component
+type DrawerDescriptionProps struct {
+	Class      string
+	Attributes templ.Attributes
+}
+
+// DrawerCloseProps defines the props for the DrawerClose component
+type DrawerCloseProps struct {
+	DrawerID   string
+	Variant    string // Button variant: "default", "destructive", "outline", "secondary", "ghost", "link"
+	Class      string
+	Attributes templ.Attributes
+}
@@ -0,0 +1,49 @@
+package drawer
+
+import (
+	"github.com/coreycole/datastarui/utils"
+)
+
+// DrawerOverlayVariants returns the CSS classes for the backdrop behind the drawer
+func DrawerOverlayVariants() string {
+	return "fixed inset-0 z-50 bg-black/80 transition-opacity duration-500 data-[state=closed]:pointer-events-none data-[state=closed]:opacity-0"
+}
+
+// DrawerVariants returns the CSS classes for the sliding drawer panel
+func DrawerVariants(props DrawerProps) string {
+	// The panel sits at --drawer-offset from its open position, plus --drawer-drag while being dragged
+	baseClasses := "fixed inset-x-0 bottom-0 z-50 flex max-h-[96dvh] flex-col rounded-t-[10px] border-t bg-background outline-none touch-none " +
+		"translate-y-[calc(var(--drawer-offset)+var(--drawer-drag,0px))] transition-[translate] duration-500 ease-[cubic-bezier(0.32,0.72,0,1)] " +
+		"data-[dragging]:transition-none data-[dragging]:select-none"
+
+	return utils.TwMerge(baseClasses, props.Class)
+}
+
+// DrawerHeaderVariants returns the CSS classes for the DrawerHeader component
+func DrawerHeaderVariants(props DrawerHeaderProps) string {
+	baseClasses := "flex flex-col gap-1.5 p-4 text-center sm:text-left"
+
+	return utils.TwMerge(baseClasses, props.Class)
+}
+
+// DrawerFooterVariants returns the CSS classes for the DrawerFooter component
+func DrawerFooterVariants(props DrawerFooterProps) string {
+	// Footer pinned to the bottom of the panel
+	baseClasses := "mt-auto flex flex-col gap-2 p-4"
+
+	return utils.TwMerge(baseClasses, props.Class)
+}
+
+// DrawerTitleVariants returns the CSS classes for the DrawerTitle component
+func DrawerTitleVariants(props DrawerTitleProps) string {
+	baseClasses := "text-lg leading-none font-semibold"
+
+	return utils.TwMerge(baseClasses, props.Class)
+}
+
+// DrawerDescriptionVariants returns the CSS classes for the DrawerDescription component
+func DrawerDescriptionVariants(props DrawerDescriptionProps) string {
+	baseClasses := "text-sm text-muted-foreground"
+
+	return utils.TwMerge(baseClasses, props.Class)
+}
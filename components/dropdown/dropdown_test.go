@@ -0,0 +1,155 @@
+package dropdown
+
+import (
+	"context"
+	"html"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+var (
+	triggerPattern = regexp.MustCompile(`<button[^>]*data-slot="dropdown-menu-trigger"[^>]*>`)
+	menuPattern    = regexp.MustCompile(`<div[^>]*data-slot="dropdown-menu-content"[^>]*>`)
+	itemPattern    = regexp.MustCompile(`<div[^>]*role="menuitem"[^>]*>`)
+	attrPattern    = regexp.MustCompile(`\s([\w:.-]+)(?:="([^"]*)")?`)
+)
+
+// nest renders c with children as its children
+func nest(c templ.Component, children ...templ.Component) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		return c.Render(templ.WithChildren(ctx, templ.Join(children...)), w)
+	})
+}
+
+// renderMenu renders a dropdown holding an Edit item, a disabled Archive item and a Delete item
+func renderMenu(t *testing.T) string {
+	t.Helper()
+	component := nest(DropdownMenu(DropdownMenuProps{ID: "actions"}),
+		nest(DropdownMenuTrigger(DropdownMenuTriggerProps{ID: "actions"}), templ.Raw("Open")),
+		nest(DropdownMenuContent(DropdownMenuContentProps{ID: "actions"}),
+			nest(DropdownMenuItem(DropdownMenuItemProps{ID: "actions"}), templ.Raw("Edit")),
+			nest(DropdownMenuItem(DropdownMenuItemProps{ID: "actions", Disabled: true}), templ.Raw("Archive")),
+			nest(DropdownMenuItem(DropdownMenuItemProps{ID: "actions"}), templ.Raw("Delete")),
+		),
+	)
+
+	var b strings.Builder
+	if err := component.Render(context.Background(), &b); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	return b.String()
+}
+
+// attrs returns the unescaped attributes of each opening tag matching pattern in out
+func attrs(pattern *regexp.Regexp, out string) []map[string]string {
+	var tags []map[string]string
+	for _, tag := range pattern.FindAllString(out, -1) {
+		attributes := map[string]string{}
+		for _, m := range attrPattern.FindAllStringSubmatch(strings.TrimSuffix(tag, ">"), -1) {
+			attributes[m[1]] = html.UnescapeString(m[2])
+		}
+		tags = append(tags, attributes)
+	}
+	return tags
+}
+
+func TestDropdownMenuHighlightsEnabledItems(t *testing.T) {
+	out := renderMenu(t)
+
+	menus := attrs(menuPattern, out)
+	if len(menus) != 1 {
+		t.Fatalf("got %d menus, want 1", len(menus))
+	}
+	if menus[0]["role"] != "menu" || menus[0]["tabindex"] != "-1" {
+		t.Errorf("menu role = %q, tabindex = %q, want menu, -1", menus[0]["role"], menus[0]["tabindex"])
+	}
+	if !strings.Contains(menus[0]["data-attr-aria-activedescendant"], "$actions.highlighted") {
+		t.Errorf("menu aria-activedescendant %q does not follow the highlighted item", menus[0]["data-attr-aria-activedescendant"])
+	}
+
+	items := attrs(itemPattern, out)
+	if len(items) != 3 {
+		t.Fatalf("got %d items, want 3", len(items))
+	}
+	for _, i := range []int{0, 2} {
+		item := items[i]
+		if item["tabindex"] != "-1" {
+			t.Errorf("item %d: tabindex = %q, want -1", i, item["tabindex"])
+		}
+		if item["data-attr-data-highlighted"] != "$actions.highlighted === +el.dataset.index" {
+			t.Errorf("item %d: data-highlighted = %q", i, item["data-attr-data-highlighted"])
+		}
+		if !strings.Contains(item["data-on-mouseenter"], "$actions.highlighted = +t.dataset.index") {
+			t.Errorf("item %d: mouseenter %q does not highlight the item", i, item["data-on-mouseenter"])
+		}
+	}
+}
+
+func TestDropdownMenuDisabledItemIsInert(t *testing.T) {
+	items := attrs(itemPattern, renderMenu(t))
+	if len(items) != 3 {
+		t.Fatalf("got %d items, want 3", len(items))
+	}
+
+	disabled := items[1]
+	if _, ok := disabled["data-disabled"]; !ok {
+		t.Error("disabled item is missing data-disabled")
+	}
+	if disabled["aria-disabled"] != "true" {
+		t.Errorf("disabled item aria-disabled = %q, want true", disabled["aria-disabled"])
+	}
+	for _, handler := range []string{"data-on-click", "data-on-mouseenter"} {
+		if _, ok := disabled[handler]; ok {
+			t.Errorf("disabled item has a %s handler", handler)
+		}
+	}
+	for _, i := range []int{0, 2} {
+		if _, ok := items[i]["data-disabled"]; ok {
+			t.Errorf("item %d is marked data-disabled", i)
+		}
+		if _, ok := items[i]["data-on-click"]; !ok {
+			t.Errorf("item %d has no click handler", i)
+		}
+	}
+}
+
+func TestDropdownMenuReturnsFocusToTrigger(t *testing.T) {
+	out := renderMenu(t)
+
+	if triggers := attrs(triggerPattern, out); len(triggers) != 1 {
+		t.Fatalf("got %d triggers, want 1", len(triggers))
+	}
+	menus := attrs(menuPattern, out)
+	if len(menus) != 1 {
+		t.Fatalf("got %d menus, want 1", len(menus))
+	}
+	onChange := menus[0]["data-on-signal-change"]
+	if !strings.Contains(onChange, "r.querySelector('[data-slot=dropdown-menu-trigger]')") || !strings.Contains(onChange, "?.focus()") {
+		t.Errorf("closing the menu does not focus the trigger: %s", onChange)
+	}
+}
+
+func TestRequestJsRejectsInvalidActions(t *testing.T) {
+	tests := []struct {
+		action DropdownMenuAction
+		want   string
+		ok     bool
+	}{
+		{DropdownMenuAction{URL: "/files/1"}, `@post("/files/1")`, true},
+		{DropdownMenuAction{Method: "DELETE", URL: "/files/1"}, `@delete("/files/1")`, true},
+		{DropdownMenuAction{Method: "get", URL: "https://example.com/a'b"}, `@get("https://example.com/a'b")`, true},
+		{DropdownMenuAction{Method: "alert", URL: "/files/1"}, "", false},
+		{DropdownMenuAction{Method: "post", URL: "javascript:alert(1)"}, "", false},
+		{DropdownMenuAction{Method: "post"}, "", false},
+	}
+	for _, tt := range tests {
+		got, ok := requestJs(tt.action)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("requestJs(%+v) = %q, %v, want %q, %v", tt.action, got, ok, tt.want, tt.ok)
+		}
+	}
+}
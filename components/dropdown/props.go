@@ -50,14 +50,15 @@ type DropdownMenuContentProps struct {
 	Attributes templ.Attributes
 
 	// Align specifies the alignment relative to the trigger
-	// Options: "start", "center", "end"
+	// Options: "start" (default), "center", "end"
 	Align string
 
-	// Side specifies which side of the trigger to align to
-	// Options: "top", "right", "bottom", "left"
+	// Side specifies which side of the trigger to align to; the content flips to the
+	// opposite side (or alignment) when it would overflow the viewport
+	// Options: "top", "right", "bottom" (default), "left"
 	Side string
 
-	// SideOffset specifies the distance from the trigger
+	// SideOffset specifies the distance from the trigger in pixels (default: 4)
 	SideOffset int
 }
 
@@ -84,6 +85,132 @@ type DropdownMenuItemProps struct {
 
 	// AsChild renders the item as a child element (for composition)
 	AsChild bool
+
+	// Href renders the item as a link to this URL
+	Href string
+
+	// OnSelect is a Datastar expression run when the item is selected; calling
+	// evt.preventDefault() in it keeps the menu open and skips Href and Action
+	OnSelect string
+
+	// Action issues a server request when the item is selected
+	Action DropdownMenuAction
+}
+
+// DropdownMenuAction describes the server request a DropdownMenuItem issues when selected.
+// The handler answers like any Datastar request, with fragments or signal patches
+type DropdownMenuAction struct {
+	// Method is the request method; any other value sends no request
+	// Options: "get", "post" (default), "put", "patch", "delete"
+	Method string
+
+	// URL is the endpoint the request is sent to, relative or http(s); any other URL sends no request
+	URL string
+
+	// ConfirmID is the ID of a DropdownMenuConfirm that must be accepted before the request is sent
+	ConfirmID string
+}
+
+// DropdownMenuConfirmProps defines the properties for the DropdownMenuConfirm dialog
+type DropdownMenuConfirmProps struct {
+	// ID identifies the confirmation, referenced by DropdownMenuAction.ConfirmID
+	ID string
+
+	// Title is the question asked
+	Title string
+
+	// Description explains the consequences of confirming
+	Description string
+
+	// ConfirmLabel is the text of the confirm button (default: "Continue")
+	ConfirmLabel string
+
+	// CancelLabel is the text of the cancel button (default: "Cancel")
+	CancelLabel string
+
+	// Variant is the confirm button variant (default: "destructive")
+	Variant string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// DropdownMenuCheckboxItemProps defines the properties for the DropdownMenuCheckboxItem component
+type DropdownMenuCheckboxItemProps struct {
+	// ID is the dropdown identifier (required to link to the correct dropdown)
+	ID string
+
+	// Name is the signal key the checked state is stored under ($<ID>.<Name>)
+	Name string
+
+	// Checked sets the initial checked state
+	Checked bool
+
+	// KeepOpen leaves the menu open after toggling
+	KeepOpen bool
+
+	// FormName submits the item in a surrounding form under this field name while checked
+	FormName string
+
+	// FormValue is the submitted value while checked (default: "on")
+	FormValue string
+
+	// Disabled makes the item non-interactive
+	Disabled bool
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// DropdownMenuRadioGroupProps defines the properties for the DropdownMenuRadioGroup component
+type DropdownMenuRadioGroupProps struct {
+	// ID is the dropdown identifier (required to link to the correct dropdown)
+	ID string
+
+	// Name is the signal key the selected value is stored under ($<ID>.<Name>)
+	Name string
+
+	// Value sets the initially selected value
+	Value string
+
+	// FormName submits the selected value in a surrounding form under this field name
+	FormName string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// DropdownMenuRadioItemProps defines the properties for the DropdownMenuRadioItem component
+type DropdownMenuRadioItemProps struct {
+	// ID is the dropdown identifier (required to link to the correct dropdown)
+	ID string
+
+	// Name must match the parent DropdownMenuRadioGroup Name
+	Name string
+
+	// Value is the value selected by this item
+	Value string
+
+	// KeepOpen leaves the menu open after selecting
+	KeepOpen bool
+
+	// Disabled makes the item non-interactive
+	Disabled bool
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
 }
 
 // DropdownMenuLabelProps defines the properties for the DropdownMenuLabel component
@@ -136,3 +263,54 @@ type DropdownMenuGroupProps struct {
 	// Attributes allows additional HTML attributes to be added
 	Attributes templ.Attributes
 }
+
+// DropdownMenuSubProps defines the properties for the DropdownMenuSub wrapper
+type DropdownMenuSubProps struct {
+	// ID is the dropdown identifier (required to link to the correct dropdown)
+	ID string
+
+	// SubID identifies this submenu (must be unique within the dropdown and a valid CSS ident)
+	SubID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// DropdownMenuSubTriggerProps defines the properties for the DropdownMenuSubTrigger component
+type DropdownMenuSubTriggerProps struct {
+	// ID is the dropdown identifier (required to link to the correct dropdown)
+	ID string
+
+	// SubID must match the parent DropdownMenuSub SubID
+	SubID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+
+	// Inset adds left padding for nested items
+	Inset bool
+
+	// Disabled makes the trigger non-interactive
+	Disabled bool
+}
+
+// DropdownMenuSubContentProps defines the properties for the DropdownMenuSubContent panel
+type DropdownMenuSubContentProps struct {
+	// ID is the dropdown identifier (required to link to the correct dropdown)
+	ID string
+
+	// SubID must match the parent DropdownMenuSub SubID
+	SubID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
@@ -2,8 +2,8 @@ package dropdown
 
 import "github.com/coreycole/datastarui/utils"
 
-// dropdownMenuVariants generates CSS classes for the main DropdownMenu root component
-func dropdownMenuVariants(className string) string {
+// DropdownMenuVariants generates CSS classes for the main DropdownMenu root component
+func DropdownMenuVariants(className string) string {
 	// Add relative positioning for proper dropdown positioning
 	baseClasses := "relative"
 
@@ -15,8 +15,8 @@ func dropdownMenuVariants(className string) string {
 	return utils.TwMerge(classes...)
 }
 
-// dropdownMenuTriggerVariants generates CSS classes for the DropdownMenuTrigger component
-func dropdownMenuTriggerVariants(className string) string {
+// DropdownMenuTriggerVariants generates CSS classes for the DropdownMenuTrigger component
+func DropdownMenuTriggerVariants(className string) string {
 	// No specific base classes for the trigger in shadcn/ui dropdown
 	classes := []string{}
 
@@ -27,10 +27,10 @@ func dropdownMenuTriggerVariants(className string) string {
 	return utils.TwMerge(classes...)
 }
 
-// dropdownMenuContentVariants generates CSS classes for the DropdownMenuContent component
-func dropdownMenuContentVariants(className string) string {
+// DropdownMenuContentVariants generates CSS classes for the DropdownMenuContent component
+func DropdownMenuContentVariants(className string) string {
 	// Extract EXACT base classes from shadcn/ui dropdown - v4 registry
-	baseClasses := "z-50 min-w-[8rem] overflow-hidden rounded-md border bg-popover p-1 text-popover-foreground shadow-md data-[state=open]:animate-in data-[state=closed]:animate-out data-[state=closed]:fade-out-0 data-[state=open]:fade-in-0 data-[state=closed]:zoom-out-95 data-[state=open]:zoom-in-95 data-[side=bottom]:slide-in-from-top-2 data-[side=left]:slide-in-from-right-2 data-[side=right]:slide-in-from-left-2 data-[side=top]:slide-in-from-bottom-2"
+	baseClasses := "z-50 min-w-[8rem] overflow-hidden rounded-md border bg-popover p-1 text-popover-foreground shadow-md outline-none data-[state=open]:animate-in data-[state=closed]:animate-out data-[state=closed]:fade-out-0 data-[state=open]:fade-in-0 data-[state=closed]:zoom-out-95 data-[state=open]:zoom-in-95 data-[side=bottom]:slide-in-from-top-2 data-[side=left]:slide-in-from-right-2 data-[side=right]:slide-in-from-left-2 data-[side=top]:slide-in-from-bottom-2"
 
 	classes := []string{baseClasses}
 	if className != "" {
@@ -40,15 +40,15 @@ func dropdownMenuContentVariants(className string) string {
 	return utils.TwMerge(classes...)
 }
 
-// dropdownMenuItemVariants generates CSS classes for the DropdownMenuItem component
-func dropdownMenuItemVariants(variant, className string, inset, disabled bool) string {
+// DropdownMenuItemVariants generates CSS classes for the DropdownMenuItem component
+func DropdownMenuItemVariants(variant, className string, inset, disabled bool) string {
 	// Extract EXACT base classes from shadcn/ui dropdown
-	baseClasses := "focus:bg-accent focus:text-accent-foreground relative flex cursor-default items-center gap-2 rounded-sm px-2 py-1.5 text-sm outline-hidden select-none data-[disabled]:pointer-events-none data-[disabled]:opacity-50 [&_svg]:pointer-events-none [&_svg]:shrink-0 [&_svg:not([class*='size-'])]:size-4"
+	baseClasses := "focus:bg-accent focus:text-accent-foreground data-[highlighted]:bg-accent data-[highlighted]:text-accent-foreground relative flex cursor-default items-center gap-2 rounded-sm px-2 py-1.5 text-sm outline-hidden select-none data-[disabled]:pointer-events-none data-[disabled]:opacity-50 [&_svg]:pointer-events-none [&_svg]:shrink-0 [&_svg:not([class*='size-'])]:size-4"
 
 	// Map EXACT variant classes from shadcn/ui
 	variantClasses := map[string]string{
 		"default":     "",
-		"destructive": "data-[variant=destructive]:text-destructive data-[variant=destructive]:focus:bg-destructive/10 dark:data-[variant=destructive]:focus:bg-destructive/20 data-[variant=destructive]:focus:text-destructive data-[variant=destructive]:*:[svg]:!text-destructive",
+		"destructive": "data-[variant=destructive]:text-destructive data-[variant=destructive]:focus:bg-destructive/10 dark:data-[variant=destructive]:focus:bg-destructive/20 data-[variant=destructive]:focus:text-destructive data-[variant=destructive]:data-[highlighted]:bg-destructive/10 dark:data-[variant=destructive]:data-[highlighted]:bg-destructive/20 data-[variant=destructive]:data-[highlighted]:text-destructive data-[variant=destructive]:*:[svg]:!text-destructive",
 	}
 
 	// Apply defaults
@@ -73,8 +73,21 @@ func dropdownMenuItemVariants(variant, className string, inset, disabled bool) s
 	return utils.TwMerge(classes...)
 }
 
-// dropdownMenuLabelVariants generates CSS classes for the DropdownMenuLabel component
-func dropdownMenuLabelVariants(className string, inset bool) string {
+// DropdownMenuCheckableItemVariants generates CSS classes for the checkbox and radio items
+func DropdownMenuCheckableItemVariants(className string) string {
+	// Extract EXACT base classes from shadcn/ui dropdown
+	baseClasses := "focus:bg-accent focus:text-accent-foreground data-[highlighted]:bg-accent data-[highlighted]:text-accent-foreground relative flex cursor-default items-center gap-2 rounded-sm py-1.5 pr-2 pl-8 text-sm outline-hidden select-none data-[disabled]:pointer-events-none data-[disabled]:opacity-50 [&_svg]:pointer-events-none [&_svg]:shrink-0 [&_svg:not([class*='size-'])]:size-4"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
+
+// DropdownMenuLabelVariants generates CSS classes for the DropdownMenuLabel component
+func DropdownMenuLabelVariants(className string, inset bool) string {
 	// Extract EXACT base classes from shadcn/ui dropdown
 	baseClasses := "px-2 py-1.5 text-sm font-medium"
 
@@ -89,8 +102,8 @@ func dropdownMenuLabelVariants(className string, inset bool) string {
 	return utils.TwMerge(classes...)
 }
 
-// dropdownMenuSeparatorVariants generates CSS classes for the DropdownMenuSeparator component
-func dropdownMenuSeparatorVariants(className string) string {
+// DropdownMenuSeparatorVariants generates CSS classes for the DropdownMenuSeparator component
+func DropdownMenuSeparatorVariants(className string) string {
 	// Extract EXACT base classes from shadcn/ui dropdown
 	baseClasses := "bg-border -mx-1 my-1 h-px"
 
@@ -102,8 +115,8 @@ func dropdownMenuSeparatorVariants(className string) string {
 	return utils.TwMerge(classes...)
 }
 
-// dropdownMenuShortcutVariants generates CSS classes for the DropdownMenuShortcut component
-func dropdownMenuShortcutVariants(className string) string {
+// DropdownMenuShortcutVariants generates CSS classes for the DropdownMenuShortcut component
+func DropdownMenuShortcutVariants(className string) string {
 	// Extract EXACT base classes from shadcn/ui dropdown
 	baseClasses := "text-muted-foreground ml-auto text-xs tracking-widest"
 
@@ -115,8 +128,8 @@ func dropdownMenuShortcutVariants(className string) string {
 	return utils.TwMerge(classes...)
 }
 
-// dropdownMenuGroupVariants generates CSS classes for the DropdownMenuGroup component
-func dropdownMenuGroupVariants(className string) string {
+// DropdownMenuGroupVariants generates CSS classes for the DropdownMenuGroup component
+func DropdownMenuGroupVariants(className string) string {
 	// No specific base classes for the group element in shadcn/ui dropdown
 	classes := []string{}
 
@@ -126,3 +139,32 @@ func dropdownMenuGroupVariants(className string) string {
 
 	return utils.TwMerge(classes...)
 }
+
+// DropdownMenuSubTriggerVariants generates CSS classes for the DropdownMenuSubTrigger component
+func DropdownMenuSubTriggerVariants(className string, inset bool) string {
+	// Extract EXACT base classes from shadcn/ui dropdown
+	baseClasses := "focus:bg-accent focus:text-accent-foreground data-[highlighted]:bg-accent data-[highlighted]:text-accent-foreground data-[state=open]:bg-accent data-[state=open]:text-accent-foreground flex cursor-default items-center gap-2 rounded-sm px-2 py-1.5 text-sm outline-hidden select-none data-[disabled]:pointer-events-none data-[disabled]:opacity-50 [&_svg]:pointer-events-none [&_svg]:shrink-0 [&_svg:not([class*='size-'])]:size-4"
+
+	classes := []string{baseClasses}
+	if inset {
+		classes = append(classes, "data-[inset]:pl-8")
+	}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
+
+// DropdownMenuSubContentVariants generates CSS classes for the DropdownMenuSubContent panel
+func DropdownMenuSubContentVariants(className string) string {
+	// Extract EXACT base classes from shadcn/ui dropdown
+	baseClasses := "bg-popover text-popover-foreground data-[state=open]:animate-in data-[state=closed]:animate-out data-[state=closed]:fade-out-0 data-[state=open]:fade-in-0 data-[state=closed]:zoom-out-95 data-[state=open]:zoom-in-95 data-[side=bottom]:slide-in-from-top-2 data-[side=left]:slide-in-from-right-2 data-[side=right]:slide-in-from-left-2 data-[side=top]:slide-in-from-bottom-2 z-50 min-w-[8rem] overflow-hidden rounded-md border p-1 shadow-lg outline-none"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
@@ -0,0 +1,64 @@
+package hovercard
+
+import "github.com/a-h/templ"
+
+// HoverCardProps defines the properties for the HoverCard root component
+type HoverCardProps struct {
+	// ID provides a unique identifier for the hover card (auto-generated if not provided)
+	ID string
+
+	// OpenDelay is the hover duration in milliseconds before opening (default: 700)
+	OpenDelay int
+
+	// CloseDelay is the duration in milliseconds before closing after the pointer leaves (default: 300)
+	CloseDelay int
+
+	// ContentURL enables lazy mode: the first time the card opens a Datastar @get is issued
+	// to this endpoint, which responds with a fragment replacing the card body (see BodyID).
+	// If the request ends without replacing the body, the next open requests it again
+	ContentURL string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// HoverCardTriggerProps defines the properties for the HoverCardTrigger component
+type HoverCardTriggerProps struct {
+	// ID is the hover card identifier (required to link to the correct card)
+	ID string
+
+	// Href renders the trigger as a link when set
+	Href string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// HoverCardContentProps defines the properties for the HoverCardContent panel
+type HoverCardContentProps struct {
+	// ID is the hover card identifier (required to link to the correct card)
+	ID string
+
+	// Side specifies which side of the trigger to position on
+	// Options: "top", "right", "bottom", "left" (default: "bottom")
+	Side string
+
+	// Align specifies the alignment relative to the trigger
+	// Options: "start", "center", "end" (default: "center")
+	Align string
+
+	// SideOffset is the distance in pixels from the trigger (default: 4)
+	SideOffset int
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
@@ -0,0 +1,28 @@
+package hovercard
+
+import "github.com/coreycole/datastarui/utils"
+
+// hoverCardTriggerVariants generates CSS classes for the HoverCardTrigger component
+func hoverCardTriggerVariants(className string) string {
+	// No specific base classes for the trigger in shadcn/ui hover card
+	classes := []string{}
+
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
+
+// hoverCardContentVariants generates CSS classes for the HoverCardContent panel
+func hoverCardContentVariants(className string) string {
+	// Extract EXACT base classes from shadcn/ui hover card
+	baseClasses := "bg-popover text-popover-foreground data-[state=open]:animate-in data-[state=closed]:animate-out data-[state=closed]:fade-out-0 data-[state=open]:fade-in-0 data-[state=closed]:zoom-out-95 data-[state=open]:zoom-in-95 data-[side=bottom]:slide-in-from-top-2 data-[side=left]:slide-in-from-right-2 data-[side=right]:slide-in-from-left-2 data-[side=top]:slide-in-from-bottom-2 z-50 w-64 rounded-md border p-4 shadow-md outline-hidden"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
@@ -0,0 +1,109 @@
+package menu
+
+import (
+	"strconv"
+
+	"github.com/coreycole/datastarui/utils"
+)
+
+// HoverDelay is how long, in milliseconds, the pointer must rest on an item before hovering
+// opens or closes a submenu, so crossing other items on the way into a submenu keeps it open
+const HoverDelay = 100
+
+// ItemsJs is an expression listing the enabled items of the menu m, without those of its submenus
+const ItemsJs = "[...m.querySelectorAll('[role^=menuitem]:not([data-disabled])')].filter(i => i.closest('[role=menu]') === m)"
+
+// rootJs returns an expression for the menu root holding the element el, slot being the
+// data-slot of the root shared by the dropdown menu, context menu and menubar builders below
+func rootJs(slot string) string {
+	return "el.closest('[data-slot=" + slot + "]')"
+}
+
+// SubPathJs returns an expression building the submenu path of the element el,
+// optionally ending with the given SubID (e.g. "share/more")
+func SubPathJs(slot, subID string) string {
+	path := "[..." + rootJs(slot) + ".querySelectorAll('[data-slot=" + slot + "-sub-content]')].filter(c => c.contains(el)).map(c => c.dataset.subId)"
+	if subID != "" {
+		path += ".concat(" + strconv.Quote(subID) + ")"
+	}
+	return path + ".join('/')"
+}
+
+// SubOpenJs returns an expression checking whether the given SubID is part of the submenu path sub
+func SubOpenJs(sub, subID string) string {
+	return "('/' + " + sub + " + '/').includes(" + strconv.Quote("/"+subID+"/") + ")"
+}
+
+// HoverJs returns an expression running expr after the hover delay, replacing any hover action
+// of the menu still pending
+func HoverJs(slot, expr string) string {
+	return "(r => (clearTimeout(r.subTimer), r.subTimer = setTimeout(() => " + expr + ", " + strconv.Itoa(HoverDelay) + ")))(" + rootJs(slot) + ")"
+}
+
+// CancelHoverJs returns an expression cancelling the pending hover action of the menu
+func CancelHoverJs(slot string) string {
+	return "clearTimeout(" + rootJs(slot) + ".subTimer)"
+}
+
+// NavigationTargetJs returns an expression for the item the key k moves to among the items is
+// from position p (-1 for none): ArrowDown/ArrowUp step without wrapping, Home/End jump to the
+// ends; any other key evaluates fallback
+func NavigationTargetJs(fallback string) string {
+	return "k === 'ArrowDown' ? is[Math.min(p + 1, is.length - 1)] : " +
+		"k === 'ArrowUp' ? (p < 0 ? is.at(-1) : is[Math.max(p - 1, 0)]) : " +
+		"k === 'Home' ? is[0] : " +
+		"k === 'End' ? is.at(-1) : " + fallback
+}
+
+// TypeaheadKeyJs is an expression checking whether the key k types into the typeahead; space
+// only continues a typeahead already under way, and modified keys are left alone
+const TypeaheadKeyJs = "k.length === 1 && !(k === ' ' && !r.typeahead) && !evt.ctrlKey && !evt.metaKey && !evt.altKey"
+
+// TypeaheadJs is an expression for the item among is that typing k moves to from position p:
+// typed characters accumulate on the root r for a second and match the start of an item's text;
+// repeating one character cycles through the items starting with it
+const TypeaheadJs = "(b => (clearTimeout(r.typeaheadTimer), r.typeaheadTimer = setTimeout(() => r.typeahead = '', 1000), r.typeahead = b, " +
+	"(c => (o => o.find(i => i.textContent.trim().toLowerCase().startsWith(c)))(" +
+	"c.length > 1 ? [...is.slice(Math.max(p, 0)), ...is.slice(0, Math.max(p, 0))] : [...is.slice(p + 1), ...is.slice(0, p + 1)]))(" +
+	"b.split('').every(ch => ch === b[0]) ? b[0] : b)))((r.typeahead || '') + k.toLowerCase())"
+
+// FocusNavigationJs returns a keydown expression moving focus between the items of the menu holding it
+func FocusNavigationJs() string {
+	return "(k => (m => m ? (is => (p => (t => t ? (evt.preventDefault(), t.focus()) : null)(" + NavigationTargetJs("null") + "))(" +
+		"is.indexOf(document.activeElement)))(" + ItemsJs + ") : null)(evt.target.closest('[role=menu]')))(evt.key)"
+}
+
+// CheckboxSelectJs returns the click expression of a checkbox item toggling the boolean signal
+// name, then running closeExpr unless keepOpen
+func CheckboxSelectJs(signals *utils.SignalManager, name string, keepOpen bool, closeExpr string) string {
+	expr := signals.Toggle(name)
+	if !keepOpen {
+		expr += "; " + closeExpr
+	}
+	return expr
+}
+
+// RadioCheckedJs returns an expression checking whether value is selected in the radio group name
+func RadioCheckedJs(signals *utils.SignalManager, name, value string) string {
+	return signals.Signal(name) + " === " + strconv.Quote(value)
+}
+
+// RadioSelectJs returns the click expression of a radio item selecting value in the radio group
+// name, then running closeExpr unless keepOpen
+func RadioSelectJs(signals *utils.SignalManager, name, value string, keepOpen bool, closeExpr string) string {
+	expr := signals.Set(name, strconv.Quote(value))
+	if !keepOpen {
+		expr += "; " + closeExpr
+	}
+	return expr
+}
+
+// AriaCheckedJs returns the aria-checked expression of an item that is checked while checked holds
+func AriaCheckedJs(checked string) string {
+	return checked + " ? 'true' : 'false'"
+}
+
+// CheckedStateJs returns the data-state expression of an item that is checked while checked holds
+func CheckedStateJs(checked string) string {
+	return checked + " ? 'checked' : 'unchecked'"
+}
@@ -0,0 +1,263 @@
+package menubar
+
+import "github.com/a-h/templ"
+
+// MenubarProps defines the properties for the Menubar root component
+type MenubarProps struct {
+	// ID provides a unique identifier for the menubar (auto-generated if not provided)
+	ID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// MenubarMenuProps defines the properties for a single MenubarMenu (trigger + content)
+type MenubarMenuProps struct {
+	// ID is the menubar identifier (required to link to the correct menubar)
+	ID string
+
+	// Value identifies this menu within the menubar
+	Value string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// MenubarTriggerProps defines the properties for the MenubarTrigger button
+type MenubarTriggerProps struct {
+	// ID is the menubar identifier (required to link to the correct menubar)
+	ID string
+
+	// Value must match the parent MenubarMenu Value
+	Value string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+
+	// Disabled makes the trigger non-interactive
+	Disabled bool
+}
+
+// MenubarContentProps defines the properties for the MenubarContent panel
+type MenubarContentProps struct {
+	// ID is the menubar identifier (required to link to the correct menubar)
+	ID string
+
+	// Value must match the parent MenubarMenu Value
+	Value string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+
+	// Align specifies the alignment relative to the trigger
+	// Options: "start", "center", "end" (default: "start")
+	Align string
+
+	// SideOffset is the distance in pixels below the trigger (default: 8)
+	SideOffset int
+
+	// AlignOffset shifts a start or end aligned panel in pixels along the alignment axis (default: -4)
+	AlignOffset int
+}
+
+// MenubarItemProps defines the properties for the MenubarItem component
+type MenubarItemProps struct {
+	// ID is the menubar identifier (required to link to the correct menubar)
+	ID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+
+	// Inset adds left padding for nested items
+	Inset bool
+
+	// Variant defines the visual style of the item
+	// Options: "default", "destructive"
+	Variant string
+
+	// Disabled makes the item non-interactive
+	Disabled bool
+}
+
+// MenubarCheckboxItemProps defines the properties for the MenubarCheckboxItem component
+type MenubarCheckboxItemProps struct {
+	// ID is the menubar identifier (required to link to the correct menubar)
+	ID string
+
+	// Name is the signal key the checked state is stored under ($<ID>.<Name>)
+	Name string
+
+	// Checked sets the initial checked state
+	Checked bool
+
+	// KeepOpen leaves the menu open after toggling
+	KeepOpen bool
+
+	// Disabled makes the item non-interactive
+	Disabled bool
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// MenubarRadioGroupProps defines the properties for the MenubarRadioGroup component
+type MenubarRadioGroupProps struct {
+	// ID is the menubar identifier (required to link to the correct menubar)
+	ID string
+
+	// Name is the signal key the selected value is stored under ($<ID>.<Name>)
+	Name string
+
+	// Value sets the initially selected value
+	Value string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// MenubarRadioItemProps defines the properties for the MenubarRadioItem component
+type MenubarRadioItemProps struct {
+	// ID is the menubar identifier (required to link to the correct menubar)
+	ID string
+
+	// Name must match the parent MenubarRadioGroup Name
+	Name string
+
+	// Value is the value selected by this item
+	Value string
+
+	// KeepOpen leaves the menu open after selecting
+	KeepOpen bool
+
+	// Disabled makes the item non-interactive
+	Disabled bool
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// MenubarLabelProps defines the properties for the MenubarLabel component
+type MenubarLabelProps struct {
+	// ID is the menubar identifier (optional for labels)
+	ID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+
+	// Inset adds left padding for nested labels
+	Inset bool
+}
+
+// MenubarSeparatorProps defines the properties for the MenubarSeparator component
+type MenubarSeparatorProps struct {
+	// ID is the menubar identifier (optional for separators)
+	ID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// MenubarShortcutProps defines the properties for the MenubarShortcut component
+type MenubarShortcutProps struct {
+	// ID is the menubar identifier (optional for shortcuts)
+	ID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// MenubarGroupProps defines the properties for the MenubarGroup component
+type MenubarGroupProps struct {
+	// ID is the menubar identifier (optional for groups)
+	ID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// MenubarSubProps defines the properties for the MenubarSub wrapper
+type MenubarSubProps struct {
+	// ID is the menubar identifier (required to link to the correct menubar)
+	ID string
+
+	// SubID identifies this submenu (must be unique within the menubar)
+	SubID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// MenubarSubTriggerProps defines the properties for the MenubarSubTrigger component
+type MenubarSubTriggerProps struct {
+	// ID is the menubar identifier (required to link to the correct menubar)
+	ID string
+
+	// SubID must match the parent MenubarSub SubID
+	SubID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+
+	// Inset adds left padding for nested items
+	Inset bool
+
+	// Disabled makes the trigger non-interactive
+	Disabled bool
+}
+
+// MenubarSubContentProps defines the properties for the MenubarSubContent panel
+type MenubarSubContentProps struct {
+	// ID is the menubar identifier (required to link to the correct menubar)
+	ID string
+
+	// SubID must match the parent MenubarSub SubID
+	SubID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
@@ -0,0 +1,80 @@
+package menubar
+
+import (
+	"github.com/coreycole/datastarui/components/dropdown"
+	"github.com/coreycole/datastarui/utils"
+)
+
+// menubarVariants generates CSS classes for the Menubar root component
+func menubarVariants(className string) string {
+	// Extract EXACT base classes from shadcn/ui menubar
+	baseClasses := "bg-background flex h-9 items-center gap-1 rounded-md border p-1 shadow-xs"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
+
+// menubarTriggerVariants generates CSS classes for the MenubarTrigger component
+func menubarTriggerVariants(className string) string {
+	// Extract EXACT base classes from shadcn/ui menubar
+	baseClasses := "focus:bg-accent focus:text-accent-foreground data-[state=open]:bg-accent data-[state=open]:text-accent-foreground flex items-center rounded-sm px-2 py-1 text-sm font-medium outline-hidden select-none disabled:pointer-events-none disabled:opacity-50"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
+
+// menubarContentVariants generates CSS classes for the MenubarContent panel
+func menubarContentVariants(className string) string {
+	// Same surface as the dropdown content with the wider shadcn/ui menubar minimum width
+	return dropdown.DropdownMenuContentVariants(utils.TwMerge("min-w-[12rem]", className))
+}
+
+// menubarSubTriggerVariants generates CSS classes for the MenubarSubTrigger component
+func menubarSubTriggerVariants(className string, inset bool) string {
+	// Extract EXACT base classes from shadcn/ui menubar
+	baseClasses := "focus:bg-accent focus:text-accent-foreground data-[state=open]:bg-accent data-[state=open]:text-accent-foreground flex cursor-default items-center rounded-sm px-2 py-1.5 text-sm outline-none select-none data-[disabled]:pointer-events-none data-[disabled]:opacity-50"
+
+	classes := []string{baseClasses}
+	if inset {
+		classes = append(classes, "data-[inset]:pl-8")
+	}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
+
+// menubarSubContentVariants generates CSS classes for the MenubarSubContent panel
+func menubarSubContentVariants(className string) string {
+	// Extract EXACT base classes from shadcn/ui menubar, anchored beside the sub trigger
+	baseClasses := "bg-popover text-popover-foreground data-[state=open]:animate-in data-[state=closed]:animate-out data-[state=closed]:fade-out-0 data-[state=open]:fade-in-0 data-[state=closed]:zoom-out-95 data-[state=open]:zoom-in-95 z-50 min-w-[8rem] overflow-hidden rounded-md border p-1 shadow-lg -mt-1"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
+
+// menubarCheckableItemVariants generates CSS classes for checkbox and radio items
+func menubarCheckableItemVariants(className string) string {
+	// Extract EXACT base classes from shadcn/ui menubar
+	baseClasses := "focus:bg-accent focus:text-accent-foreground relative flex cursor-default items-center gap-2 rounded-xs py-1.5 pr-2 pl-8 text-sm outline-hidden select-none data-[disabled]:pointer-events-none data-[disabled]:opacity-50 [&_svg]:pointer-events-none [&_svg]:shrink-0 [&_svg:not([class*='size-'])]:size-4"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
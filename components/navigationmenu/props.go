@@ -0,0 +1,102 @@
+package navigationmenu
+
+import "github.com/a-h/templ"
+
+// NavigationMenuProps defines the properties for the NavigationMenu root component
+type NavigationMenuProps struct {
+	// ID provides a unique identifier for the navigation menu (auto-generated if not provided)
+	ID string
+
+	// DelayDuration is the hover duration in milliseconds before a closed menu opens (default: 200)
+	DelayDuration int
+
+	// CloseDelay is the duration in milliseconds before closing after the pointer leaves (default: 300)
+	CloseDelay int
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// NavigationMenuListProps defines the properties for the NavigationMenuList component
+type NavigationMenuListProps struct {
+	// ID is the navigation menu identifier (required to link to the correct menu)
+	ID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// NavigationMenuItemProps defines the properties for the NavigationMenuItem component
+type NavigationMenuItemProps struct {
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// NavigationMenuTriggerProps defines the properties for the NavigationMenuTrigger component
+type NavigationMenuTriggerProps struct {
+	// ID is the navigation menu identifier (required to link to the correct menu)
+	ID string
+
+	// Value identifies the content panel this trigger reveals
+	Value string
+
+	// Disabled makes the trigger non-interactive
+	Disabled bool
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// NavigationMenuContentProps defines the properties for the NavigationMenuContent panel
+type NavigationMenuContentProps struct {
+	// ID is the navigation menu identifier (required to link to the correct menu)
+	ID string
+
+	// Value must match the NavigationMenuTrigger Value
+	Value string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// NavigationMenuLinkProps defines the properties for the NavigationMenuLink component
+type NavigationMenuLinkProps struct {
+	// Href is the link destination
+	Href string
+
+	// Active marks the link as the current page
+	Active bool
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// NavigationMenuIndicatorProps defines the properties for the NavigationMenuIndicator arrow
+type NavigationMenuIndicatorProps struct {
+	// ID is the navigation menu identifier (required to link to the correct menu)
+	ID string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
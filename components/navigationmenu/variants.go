@@ -0,0 +1,59 @@
+package navigationmenu
+
+import "github.com/coreycole/datastarui/utils"
+
+// navigationMenuVariants generates CSS classes for the NavigationMenu root component
+func navigationMenuVariants(className string) string {
+	// Extract EXACT base classes from shadcn/ui navigation menu
+	baseClasses := "group/navigation-menu relative flex max-w-max flex-1 items-center justify-center"
+
+	return utils.TwMerge(baseClasses, className)
+}
+
+// navigationMenuListVariants generates CSS classes for the NavigationMenuList component
+func navigationMenuListVariants(className string) string {
+	// Extract EXACT base classes from shadcn/ui navigation menu
+	baseClasses := "group flex flex-1 list-none items-center justify-center gap-1"
+
+	return utils.TwMerge(baseClasses, className)
+}
+
+// NavigationMenuTriggerStyle returns the trigger classes, also used to style top-level NavigationMenuLinks
+// This mirrors navigationMenuTriggerStyle from shadcn/ui
+func NavigationMenuTriggerStyle(className string) string {
+	// Extract EXACT base classes from shadcn/ui navigation menu
+	baseClasses := "group inline-flex h-9 w-max items-center justify-center rounded-md bg-background px-4 py-2 text-sm font-medium hover:bg-accent hover:text-accent-foreground focus:bg-accent focus:text-accent-foreground disabled:pointer-events-none disabled:opacity-50 data-[state=open]:hover:bg-accent data-[state=open]:text-accent-foreground data-[state=open]:focus:bg-accent data-[state=open]:bg-accent/50 focus-visible:ring-ring/50 outline-none transition-[color,box-shadow] focus-visible:ring-[3px] focus-visible:outline-1"
+
+	return utils.TwMerge(baseClasses, className)
+}
+
+// navigationMenuContentVariants generates CSS classes for the NavigationMenuContent panel
+func navigationMenuContentVariants(className string) string {
+	// Panels are positioned against the root (not the item) so every panel shares the viewport's
+	// position; the transparent border lines the panel up with the viewport's border
+	baseClasses := "data-[state=open]:animate-in data-[state=open]:fade-in absolute top-full left-0 z-[51] mt-1.5 w-max border border-transparent p-2 pr-2.5"
+
+	return utils.TwMerge(baseClasses, className)
+}
+
+// navigationMenuViewportVariants generates CSS classes for the shared NavigationMenu viewport
+func navigationMenuViewportVariants() string {
+	// Extract EXACT base classes from shadcn/ui navigation menu, sized by CSS variables set on open
+	return "origin-top bg-popover text-popover-foreground data-[state=open]:animate-in data-[state=closed]:animate-out data-[state=closed]:zoom-out-95 data-[state=open]:zoom-in-90 relative mt-1.5 h-[var(--navigation-menu-viewport-height)] w-[var(--navigation-menu-viewport-width)] overflow-hidden rounded-md border shadow transition-[width,height] duration-300"
+}
+
+// navigationMenuLinkVariants generates CSS classes for the NavigationMenuLink component
+func navigationMenuLinkVariants(className string) string {
+	// Extract EXACT base classes from shadcn/ui navigation menu
+	baseClasses := "data-[active=true]:focus:bg-accent data-[active=true]:hover:bg-accent data-[active=true]:bg-accent/50 data-[active=true]:text-accent-foreground hover:bg-accent hover:text-accent-foreground focus:bg-accent focus:text-accent-foreground focus-visible:ring-ring/50 [&_svg:not([class*='text-'])]:text-muted-foreground flex flex-col gap-1 rounded-sm p-2 text-sm transition-all outline-none focus-visible:ring-[3px] focus-visible:outline-1 [&_svg:not([class*='size-'])]:size-4"
+
+	return utils.TwMerge(baseClasses, className)
+}
+
+// navigationMenuIndicatorVariants generates CSS classes for the NavigationMenuIndicator arrow
+func navigationMenuIndicatorVariants(className string) string {
+	// Extract EXACT base classes from shadcn/ui navigation menu, moved under the open trigger
+	baseClasses := "data-[state=visible]:animate-in data-[state=hidden]:animate-out data-[state=hidden]:fade-out data-[state=visible]:fade-in absolute top-full left-[var(--navigation-menu-indicator-left)] z-[52] flex h-1.5 w-[var(--navigation-menu-indicator-width)] items-end justify-center overflow-hidden transition-[left,width] duration-300"
+
+	return utils.TwMerge(baseClasses, className)
+}
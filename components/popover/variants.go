@@ -64,3 +64,38 @@ func GetAnchorPosition(side, align string, sideOffset int) string {
 		return "top: anchor(bottom); left: anchor(center); translate: -50% " + offset
 	}
 }
+
+// GetAnchorArea generates CSS placing an anchored element on side of its anchor with align,
+// sideOffset pixels away. Unlike GetAnchorPosition the offset is a margin, so the
+// position-try-fallbacks can flip the element to the opposite side or alignment when it
+// would overflow its containing block; pair it with position: fixed to collide with the viewport
+func GetAnchorArea(side, align string, sideOffset int) string {
+	offset := strconv.Itoa(sideOffset) + "px"
+
+	// Alignment spans away from the anchor's start or end edge along the cross axis
+	span := func(start, end string) string {
+		switch align {
+		case "start":
+			return " span-" + start
+		case "end":
+			return " span-" + end
+		default: // center
+			return ""
+		}
+	}
+
+	switch side {
+	case "top":
+		return "position-area: top" + span("right", "left") + "; margin-bottom: " + offset +
+			"; position-try-fallbacks: flip-block, flip-inline, flip-block flip-inline"
+	case "right":
+		return "position-area: right" + span("bottom", "top") + "; margin-left: " + offset +
+			"; position-try-fallbacks: flip-inline, flip-block, flip-inline flip-block"
+	case "left":
+		return "position-area: left" + span("bottom", "top") + "; margin-right: " + offset +
+			"; position-try-fallbacks: flip-inline, flip-block, flip-inline flip-block"
+	default: // bottom
+		return "position-area: bottom" + span("right", "left") + "; margin-top: " + offset +
+			"; position-try-fallbacks: flip-block, flip-inline, flip-block flip-inline"
+	}
+}
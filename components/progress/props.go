@@ -0,0 +1,30 @@
+package progress
+
+import "github.com/a-h/templ"
+
+// ProgressProps defines the properties for the Progress component
+type ProgressProps struct {
+	// ID is a unique identifier for this progress (required for server-driven updates)
+	ID string
+
+	// Value sets the initial completion percentage (0-100)
+	Value int
+
+	// Indeterminate shows an animated bar while the amount of work is unknown
+	Indeterminate bool
+
+	// Variant selects the shape: "default" (bar) or "circular"
+	Variant string
+
+	// Size sets the circular variant's diameter in pixels (default 40)
+	Size int
+
+	// Label is the accessible name announced by screen readers
+	Label string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
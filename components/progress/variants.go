@@ -0,0 +1,35 @@
+package progress
+
+import (
+	"github.com/coreycole/datastarui/utils"
+)
+
+// progressVariants generates the CSS classes for the Progress track
+func progressVariants(className string) string {
+	// Base classes from shadcn/ui New York v4
+	baseClasses := "bg-primary/20 relative h-2 w-full overflow-hidden rounded-full"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
+
+// progressIndicatorVariants generates the CSS classes for the filled part of the bar
+func progressIndicatorVariants(indeterminate bool) string {
+	if indeterminate {
+		return "bg-primary absolute inset-y-0 left-0 w-1/3 animate-[progress-indeterminate_1.5s_ease-in-out_infinite]"
+	}
+	return "bg-primary h-full w-full flex-1 transition-all"
+}
+
+// progressCircularVariants generates the CSS classes for the circular variant's svg
+func progressCircularVariants(indeterminate bool) string {
+	if indeterminate {
+		return "animate-spin"
+	}
+	// Start the stroke at 12 o'clock
+	return "-rotate-90"
+}
@@ -0,0 +1,54 @@
+package resizable
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/coreycole/datastarui/utils"
+)
+
+// Layout holds the panel sizes of a group in percent, in document order
+type Layout []float64
+
+// CookieName returns the name of the cookie a persisted panel group saves its layout to
+func CookieName(id string) string {
+	return "resizable_" + utils.Signals(id, ResizableSignals{}).ID
+}
+
+// LoadLayout reads a persisted layout from the request cookies
+// Returns nil when the cookie is missing or malformed so callers fall back to defaults
+// Example: layout := resizable.LoadLayout(c.Request(), "editor")
+func LoadLayout(r *http.Request, id string) Layout {
+	cookie, err := r.Cookie(CookieName(id))
+	if err != nil {
+		return nil
+	}
+
+	var layout Layout
+	total := 0.0
+	for _, part := range strings.Split(cookie.Value, ":") {
+		size, err := strconv.ParseFloat(part, 64)
+		if err != nil || size < 0 || size > 100 {
+			return nil
+		}
+		layout = append(layout, size)
+		total += size
+	}
+
+	// Sizes must add up to the whole group (allowing for rounding)
+	if math.Abs(total-100) > 1 {
+		return nil
+	}
+	return layout
+}
+
+// Size returns the size of panel i, or fallback when the layout has no entry for it
+// Example: resizable.ResizablePanelProps{DefaultSize: layout.Size(0, 25)}
+func (l Layout) Size(i int, fallback float64) float64 {
+	if i < 0 || i >= len(l) {
+		return fallback
+	}
+	return l[i]
+}
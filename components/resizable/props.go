@@ -0,0 +1,54 @@
+package resizable
+
+import "github.com/a-h/templ"
+
+// ResizablePanelGroupProps defines the properties for the ResizablePanelGroup component
+type ResizablePanelGroupProps struct {
+	// ID is a unique identifier for this panel group (required for signals and persistence)
+	ID string
+
+	// Direction lays the panels out "horizontal" (default) or "vertical"
+	Direction string
+
+	// Persist saves the layout to a cookie; read it back with LoadLayout when rendering
+	Persist bool
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// ResizablePanelProps defines the properties for the ResizablePanel component
+type ResizablePanelProps struct {
+	// DefaultSize is the initial size in percent; unset panels share the remaining space
+	DefaultSize float64
+
+	// MinSize is the smallest size in percent (default 0)
+	MinSize float64
+
+	// MaxSize is the largest size in percent (default 100)
+	MaxSize float64
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// ResizableHandleProps defines the properties for the ResizableHandle component
+type ResizableHandleProps struct {
+	// ID is the panel group identifier (required to link to the correct group)
+	ID string
+
+	// WithHandle shows a visible grip on the divider
+	WithHandle bool
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
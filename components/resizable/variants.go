@@ -0,0 +1,33 @@
+package resizable
+
+import (
+	"github.com/coreycole/datastarui/utils"
+)
+
+// resizablePanelGroupVariants generates the CSS classes for the ResizablePanelGroup component
+func resizablePanelGroupVariants(className string) string {
+	// Base classes from New York v4
+	baseClasses := "flex h-full w-full data-[direction=vertical]:flex-col"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
+
+// resizableHandleVariants generates the CSS classes for the ResizableHandle component
+// The handle is one pixel along the group's main axis and stretches across it, so it fits either direction
+func resizableHandleVariants(className string) string {
+	baseClasses := "bg-border focus-visible:ring-ring relative flex flex-none basis-px self-stretch items-center justify-center touch-none select-none " +
+		"cursor-col-resize [[data-direction=vertical]>&]:cursor-row-resize after:absolute after:-inset-1 " +
+		"focus-visible:ring-1 focus-visible:ring-offset-1 focus-visible:outline-hidden [[data-direction=vertical]>&>div]:rotate-90"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
@@ -0,0 +1,21 @@
+package scrollarea
+
+import "github.com/a-h/templ"
+
+// ScrollAreaProps defines the properties for the ScrollArea component
+type ScrollAreaProps struct {
+	// Orientation sets the scrollable axes: "vertical" (default), "horizontal" or "both"
+	Orientation string
+
+	// Type sets when the scrollbars are visible: "hover" (default, while hovered or scrolling) or "always"
+	Type string
+
+	// ViewportClass allows CSS classes to be added to the scrolling viewport
+	ViewportClass string
+
+	// Class allows additional CSS classes to be added (set the height or width here)
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
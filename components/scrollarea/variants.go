@@ -0,0 +1,62 @@
+package scrollarea
+
+import (
+	"github.com/coreycole/datastarui/utils"
+)
+
+// scrollAreaVariants generates the CSS classes for the ScrollArea root component
+func scrollAreaVariants(className string) string {
+	baseClasses := "relative overflow-hidden"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
+
+// scrollAreaViewportVariants generates the CSS classes for the viewport, hiding the native scrollbars
+func scrollAreaViewportVariants(orientation, className string) string {
+	// Base classes from New York v4
+	baseClasses := "no-scrollbar focus-visible:ring-ring/50 size-full rounded-[inherit] transition-[color,box-shadow] outline-none focus-visible:ring-[3px] focus-visible:outline-1"
+
+	overflowClasses := map[string]string{
+		"vertical":   "overflow-y-auto overflow-x-hidden",
+		"horizontal": "overflow-x-auto overflow-y-hidden",
+		"both":       "overflow-auto",
+	}
+
+	classes := []string{baseClasses, overflowClasses[orientation]}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
+
+// scrollAreaScrollbarVariants generates the CSS classes for a scrollbar track
+func scrollAreaScrollbarVariants(orientation string) string {
+	// Base classes from New York v4, positioned over the viewport edge
+	baseClasses := "absolute z-10 flex touch-none p-px transition-[opacity,colors] duration-150 select-none data-[state=hidden]:opacity-0"
+
+	orientationClasses := map[string]string{
+		"vertical":   "top-0 right-0 bottom-0 h-full w-2.5 border-l border-l-transparent",
+		"horizontal": "right-0 bottom-0 left-0 h-2.5 flex-col border-t border-t-transparent",
+	}
+
+	return utils.TwMerge(baseClasses, orientationClasses[orientation])
+}
+
+// scrollAreaThumbVariants generates the CSS classes for a scrollbar thumb
+// Size and offset come from CSS variables set on the root while scrolling
+func scrollAreaThumbVariants(orientation string) string {
+	baseClasses := "bg-border absolute rounded-full hover:bg-muted-foreground/50"
+
+	orientationClasses := map[string]string{
+		"vertical":   "inset-x-px top-(--scroll-area-thumb-top) h-(--scroll-area-thumb-height)",
+		"horizontal": "inset-y-px left-(--scroll-area-thumb-left) w-(--scroll-area-thumb-width)",
+	}
+
+	return utils.TwMerge(baseClasses, orientationClasses[orientation])
+}
@@ -0,0 +1,23 @@
+package selectcomponent
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// DependsParam is the query parameter carrying the parent value when a dependent Select refreshes
+const DependsParam = "parent"
+
+// DependsValue returns the parent value a dependent Select was refreshed for
+func DependsValue(r *http.Request) string {
+	return r.URL.Query().Get(DependsParam)
+}
+
+// Selector returns the CSS selector of the Select root with the given ID, for merging a
+// re-rendered dependent Select
+// Example:
+//
+//	sse.MergeFragmentTempl(selectcomponent.Select(props), datastar.WithSelector(selectcomponent.Selector("state_select")))
+func Selector(id string) string {
+	return "[data-select-id=" + strconv.Quote(id) + "]"
+}
@@ -0,0 +1,67 @@
+package selectcomponent
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/a-h/templ"
+)
+
+// OptionSource supplies the options of a Select in OptionsURL mode, one page at a time
+type OptionSource interface {
+	// Options returns up to limit options matching query, starting at offset, and whether more follow
+	Options(ctx context.Context, query string, offset, limit int) (options []SelectOption, hasMore bool, err error)
+}
+
+// OptionSourceFunc adapts a function to the OptionSource interface
+type OptionSourceFunc func(ctx context.Context, query string, offset, limit int) ([]SelectOption, bool, error)
+
+// Options calls f(ctx, query, offset, limit)
+func (f OptionSourceFunc) Options(ctx context.Context, query string, offset, limit int) ([]SelectOption, bool, error) {
+	return f(ctx, query, offset, limit)
+}
+
+// SelectOptionsSignals defines the signals a Select in OptionsURL mode adds for paging
+type SelectOptionsSignals struct {
+	Loading bool   `json:"loading"` // Whether a page request is in flight
+	HasMore bool   `json:"hasMore"` // Whether the source has options past Offset
+	Offset  int    `json:"offset"`  // Number of options loaded so far
+	Query   string `json:"query"`   // Search query the loaded options match
+}
+
+// OptionsPage is one page of options answering an OptionsURL request
+type OptionsPage struct {
+	// Items renders the page's items; append them to OptionsListID. The page updates the paging
+	// state once merged, or removes itself if the search has changed since it was requested
+	Items templ.Component
+
+	// Query is the search query the page answers, echoed back from the request
+	Query string
+}
+
+// OptionsListID returns the ID of the element OptionsURL pages are appended to
+func OptionsListID(id string) string {
+	return id + "-options"
+}
+
+// LoadOptions answers an OptionsURL request: it reads the offset and search query the select sends
+// and asks source for the next page. On error the page has no items and stops further paging
+// Example:
+//
+//	page, err := selectcomponent.LoadOptions(c.Request(), "user_select", users, 50)
+//	sse.MergeFragmentTempl(page.Items, datastar.WithSelectorID(selectcomponent.OptionsListID("user_select")), datastar.WithMergeAppend())
+func LoadOptions(r *http.Request, id string, source OptionSource, limit int) (OptionsPage, error) {
+	offset, err := strconv.Atoi(r.URL.Query().Get("offset"))
+	if err != nil || offset < 0 {
+		offset = 0
+	}
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+
+	options, hasMore, err := source.Options(r.Context(), query, offset, limit)
+	if err != nil {
+		return OptionsPage{Items: optionsPage(id, query, offset, nil, false), Query: query}, err
+	}
+	return OptionsPage{Items: optionsPage(id, query, offset, options, hasMore), Query: query}, nil
+}
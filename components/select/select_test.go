@@ -0,0 +1,79 @@
+package selectcomponent
+
+import (
+	"context"
+	"html"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+var (
+	labelPattern = regexp.MustCompile(`data-slot="select-label"[^>]*>\s*([^<]*?)\s*<`)
+	itemPattern  = regexp.MustCompile(`data-value="([^"]*)" data-index="(\d+)"`)
+)
+
+// renderSelect renders props and returns the group labels and the "value=index" items in document order
+func renderSelect(t *testing.T, props SelectProps) (labels, items []string) {
+	t.Helper()
+	var b strings.Builder
+	if err := Select(props).Render(context.Background(), &b); err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	for _, m := range labelPattern.FindAllStringSubmatch(b.String(), -1) {
+		labels = append(labels, m[1])
+	}
+	for _, m := range itemPattern.FindAllStringSubmatch(b.String(), -1) {
+		items = append(items, m[1]+"="+m[2])
+	}
+	return labels, items
+}
+
+func TestSelectGroupOrderIsStable(t *testing.T) {
+	props := SelectProps{
+		ID:      "fruit",
+		Options: []SelectOption{{Value: "none", Label: "None"}},
+		Groups: []SelectOptionGroup{
+			{Label: "Vegetables", Options: []SelectOption{{Value: "carrot", Label: "Carrot"}, {Value: "leek", Label: "Leek"}}},
+			{Label: "Fruits", Options: []SelectOption{{Value: "apple", Label: "Apple"}}},
+			{Label: "Berries", Options: []SelectOption{{Value: "cherry", Label: "Cherry"}, {Value: "grape", Label: "Grape"}}},
+		},
+	}
+
+	wantLabels := []string{"Vegetables", "Fruits", "Berries"}
+	wantItems := []string{"none=0", "carrot=1", "leek=2", "apple=3", "cherry=4", "grape=5"}
+	for render := range 2 {
+		labels, items := renderSelect(t, props)
+		if !slices.Equal(labels, wantLabels) {
+			t.Errorf("render %d: group labels = %v, want %v", render, labels, wantLabels)
+		}
+		if !slices.Equal(items, wantItems) {
+			t.Errorf("render %d: items = %v, want %v", render, items, wantItems)
+		}
+	}
+}
+
+func TestSelectInitialLabel(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		options []SelectOption
+		want    string
+	}{
+		{"label differs from value", "apple", []SelectOption{{Value: "apple", Label: "Apple"}}, `"label":"Apple"`},
+		{"label equals value", "Apple", []SelectOption{{Value: "Apple", Label: "Apple"}}, `"label":"Apple"`},
+		{"no matching option", "apple", []SelectOption{{Value: "pear", Label: "Pear"}}, `"label":""`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			if err := Select(SelectProps{ID: "fruit", Value: tt.value, Options: tt.options}).Render(context.Background(), &b); err != nil {
+				t.Fatalf("render: %v", err)
+			}
+			if out := html.UnescapeString(b.String()); !strings.Contains(out, tt.want) {
+				t.Errorf("signals do not contain %s", tt.want)
+			}
+		})
+	}
+}
@@ -13,10 +13,24 @@ type SelectOption struct {
 	// Disabled makes this option non-selectable
 	Disabled bool `json:"disabled,omitempty"`
 
-	// Group is the optional group name for this option
+	// Group is the optional group name for this option; groups named this way are
+	// rendered in the order they first appear
 	Group string `json:"group,omitempty"`
 }
 
+// SelectOptionGroup is a labelled group of options. Groups render in declaration order,
+// after any ungrouped Options, so item indices are the same on every render
+type SelectOptionGroup struct {
+	// Label is the heading shown above the group's options
+	Label string `json:"label"`
+
+	// Description is optional helper text shown under the label
+	Description string `json:"description,omitempty"`
+
+	// Options are the group's options in display order
+	Options []SelectOption `json:"options"`
+}
+
 // SelectProps defines the properties for the main Select component
 type SelectProps struct {
 	// ID is used for scoping datastar signals
@@ -34,21 +48,61 @@ type SelectProps struct {
 	// DefaultValue is the initial value when uncontrolled
 	DefaultValue string
 
+	// Multiple keeps the list open on pick and toggles items in an array of values,
+	// shown as removable chips in the trigger
+	Multiple bool
+
+	// Values are the initially selected values when Multiple
+	Values []string
+
+	// MaxSelected limits how many values can be selected when Multiple (0 for no limit)
+	MaxSelected int
+
 	// Options is a slice of options to render automatically
 	Options []SelectOption
 
-	// Name for form submission
+	// Groups are option groups rendered after Options, in order
+	Groups []SelectOptionGroup
+
+	// OptionsURL loads the options from the server instead: opening the select requests the first
+	// page and scrolling requests more, each answered with LoadOptions. The request carries
+	// ?offset=<loaded count>&q=<search>
+	OptionsURL string
+
+	// Label is the display text for Value when it is not among Options, e.g. with OptionsURL
+	Label string
+
+	// DependsOn is the ID of a parent Select. When its value changes, this select clears its
+	// value and issues a GET to DependsURL with ?parent=<value>; the handler answers with this
+	// Select re-rendered for the new parent value, merged at Selector(ID)
+	DependsOn string
+
+	// DependsURL is the endpoint re-rendering a dependent Select
+	DependsURL string
+
+	// DependsValue is the parent value Options were built for; the handler sets it from
+	// DependsValue(r) so the select only refreshes when the parent actually changes
+	DependsValue string
+
+	// Name for form submission; when Multiple, one hidden input is submitted per value
 	Name string
 
 	// Disabled makes the select non-interactive
 	Disabled bool
 
-	// Required for form validation
+	// Required for form validation; when Multiple, at least one value must be selected
 	Required bool
 
 	// Placeholder text to show when no value is selected
 	Placeholder string
 
+	// Searchable adds a search input at the top of the list that filters the options
+	Searchable bool
+
+	// Position determines how the list is positioned relative to the trigger
+	// Options: "popper" (default), "item-aligned"
+	Position string
+
 	// Class allows additional CSS classes to be added
 	Class string
 
@@ -79,6 +133,9 @@ type SelectValueProps struct {
 	// Placeholder text to show when no value is selected
 	Placeholder string
 
+	// Multiple must match the parent Select Multiple; the selected values are then shown as chips
+	Multiple bool
+
 	// Class allows additional CSS classes to be added
 	Class string
 
@@ -92,9 +149,31 @@ type SelectContentProps struct {
 	ID string
 
 	// Position determines how the content is positioned relative to the trigger
-	// Options: "item-aligned", "popper"
+	// Options: "popper" (default) places it on Side of the trigger, flipping near the viewport edge;
+	// "item-aligned" overlays the trigger with the selected item over the value
 	Position string
 
+	// Side is the side of the trigger popper content opens on
+	// Options: "bottom" (default), "top", "right", "left"
+	Side string
+
+	// Align is the popper content alignment along the trigger
+	// Options: "start" (default), "center", "end"
+	Align string
+
+	// SideOffset is the popper content distance from the trigger in pixels (default: 4)
+	SideOffset int
+
+	// Searchable adds a search input at the top of the list that filters the items client-side
+	Searchable bool
+
+	// SearchPlaceholder replaces the search input's "Search..." placeholder
+	SearchPlaceholder string
+
+	// OptionsURL must match the parent Select OptionsURL; the items are then loaded page by page
+	// and the search is answered by the server
+	OptionsURL string
+
 	// Class allows additional CSS classes to be added
 	Class string
 
@@ -27,13 +27,10 @@ func selectTriggerVariants(class string) string {
 }
 
 // selectContentVariants returns the CSS classes for the select content
-func selectContentVariants(position string, class string) string {
-	baseClasses := "z-50 max-h-96 min-w-[8rem] overflow-hidden rounded-md border bg-popover text-popover-foreground shadow-md data-[state=open]:animate-in data-[state=closed]:animate-out data-[state=closed]:fade-out-0 data-[state=open]:fade-in-0 data-[state=closed]:zoom-out-95 data-[state=open]:zoom-in-95 data-[side=bottom]:slide-in-from-top-2 data-[side=left]:slide-in-from-right-2 data-[side=right]:slide-in-from-left-2 data-[side=top]:slide-in-from-bottom-2"
+func selectContentVariants(class string) string {
+	baseClasses := "z-50 flex max-h-96 min-w-[8rem] flex-col overflow-hidden rounded-md border bg-popover text-popover-foreground shadow-md data-[state=open]:animate-in data-[state=closed]:animate-out data-[state=closed]:fade-out-0 data-[state=open]:fade-in-0 data-[state=closed]:zoom-out-95 data-[state=open]:zoom-in-95 data-[side=bottom]:slide-in-from-top-2 data-[side=left]:slide-in-from-right-2 data-[side=right]:slide-in-from-left-2 data-[side=top]:slide-in-from-bottom-2"
 
 	classes := []string{baseClasses}
-	if position == "popper" {
-		classes = append(classes, "data-[side=bottom]:translate-y-1 data-[side=left]:-translate-x-1 data-[side=right]:translate-x-1 data-[side=top]:-translate-y-1")
-	}
 	if class != "" {
 		classes = append(classes, class)
 	}
@@ -43,7 +40,7 @@ func selectContentVariants(position string, class string) string {
 
 // selectItemVariants returns the CSS classes for the select item
 func selectItemVariants(class string) string {
-	baseClasses := "relative flex w-full cursor-default select-none items-center rounded-sm py-1.5 pl-2 pr-8 text-sm outline-none focus:bg-accent focus:text-accent-foreground data-[disabled]:pointer-events-none data-[disabled]:opacity-50"
+	baseClasses := "relative flex w-full cursor-default select-none items-center rounded-sm py-1.5 pl-2 pr-8 text-sm outline-none focus:bg-accent focus:text-accent-foreground data-[highlighted]:bg-accent data-[highlighted]:text-accent-foreground data-[disabled]:pointer-events-none data-[disabled]:opacity-50"
 
 	classes := []string{baseClasses}
 	if class != "" {
@@ -78,13 +75,22 @@ func selectSeparatorVariants(class string) string {
 }
 
 // selectViewportVariants returns the CSS classes for the select viewport
-func selectViewportVariants(position string, class string) string {
-	baseClasses := "p-1"
+func selectViewportVariants(class string) string {
+	baseClasses := "min-h-0 flex-1 overflow-y-auto p-1"
 
 	classes := []string{baseClasses}
-	if position == "popper" {
-		classes = append(classes, "h-[var(--radix-select-trigger-height)] w-full min-w-[var(--radix-select-trigger-width)]")
+	if class != "" {
+		classes = append(classes, class)
 	}
+
+	return utils.TwMerge(classes...)
+}
+
+// selectChipVariants returns the CSS classes for a chip showing a value of a multiple select
+func selectChipVariants(class string) string {
+	baseClasses := "inline-flex items-center gap-1 rounded-md border bg-secondary px-1.5 py-0.5 text-xs font-medium text-secondary-foreground [&>[role=button]]:rounded-sm [&>[role=button]]:opacity-70 [&>[role=button]:hover]:opacity-100"
+
+	classes := []string{baseClasses}
 	if class != "" {
 		classes = append(classes, class)
 	}
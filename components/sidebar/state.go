@@ -0,0 +1,30 @@
+package sidebar
+
+import (
+	"net/http"
+
+	"github.com/coreycole/datastarui/utils"
+)
+
+// DefaultID is the SidebarProvider ID used when none is given
+const DefaultID = "sidebar"
+
+// providerID returns the provider ID, falling back to DefaultID
+func providerID(id string) string {
+	if id == "" {
+		return DefaultID
+	}
+	return id
+}
+
+// CookieName returns the cookie the sidebar's expanded state is saved in
+func CookieName(id string) string {
+	return utils.Signals(providerID(id), SidebarSignals{}).ID + "_state"
+}
+
+// LoadCollapsed reports whether the sidebar was collapsed on the client's last visit,
+// so the server renders it in that state without a flash
+func LoadCollapsed(r *http.Request, id string) bool {
+	cookie, err := r.Cookie(CookieName(id))
+	return err == nil && cookie.Value == "false"
+}
@@ -5,14 +5,17 @@ import "github.com/a-h/templ"
 type SidebarItem struct {
 	Title string
 	Href  string
-	Items []SidebarItem
-	Label string // For "New" badges etc
+	Items []SidebarItem   // Rendered as a collapsible sub-menu
+	Label string          // For "New" badges etc
+	Icon  templ.Component // Optional icon shown before the title (and alone in icon mode)
 }
 
 type SidebarSection struct {
-	Title string
-	Items []SidebarItem
-	Label string // For "New" badges etc
+	Title       string
+	Items       []SidebarItem
+	Label       string // For "New" badges etc
+	Collapsible bool   // Title toggles the items via a collapsible
+	Collapsed   bool   // Initial state when Collapsible
 }
 
 type SidebarProps struct {
@@ -21,3 +24,158 @@ type SidebarProps struct {
 	Class       string
 	Attributes  templ.Attributes
 }
+
+// SidebarProviderProps defines the props for the SidebarProvider wrapping a sidebar and its inset
+type SidebarProviderProps struct {
+	ID               string // Signal namespace and cookie prefix (default "sidebar")
+	DefaultCollapsed bool   // Initial desktop state; pass LoadCollapsed(r, id) to restore the saved state
+	Class            string
+	Attributes       templ.Attributes
+}
+
+// SidebarPanelProps defines the props for the SidebarPanel
+type SidebarPanelProps struct {
+	ID          string // Must match the SidebarProvider ID (default "sidebar")
+	Side        string // "left" (default) or "right"
+	Variant     string // "sidebar" (default), "floating" or "inset"
+	Collapsible string // What collapsing does on desktop: "offcanvas" (default), "icon" or "none"
+	// DefaultCollapsed must match the SidebarProvider DefaultCollapsed so the first paint has the right state
+	DefaultCollapsed bool
+	Class            string
+	Attributes       templ.Attributes
+}
+
+// SidebarTriggerProps defines the props for the SidebarTrigger button
+type SidebarTriggerProps struct {
+	ID         string // Must match the SidebarProvider ID (default "sidebar")
+	Class      string
+	Attributes templ.Attributes
+}
+
+// SidebarRailProps defines the props for the SidebarRail along the sidebar edge
+type SidebarRailProps struct {
+	ID         string // Must match the SidebarProvider ID (default "sidebar")
+	Class      string
+	Attributes templ.Attributes
+}
+
+// SidebarInsetProps defines the props for the SidebarInset main content area
+type SidebarInsetProps struct {
+	Class      string
+	Attributes templ.Attributes
+}
+
+// SidebarHeaderProps defines the props for the SidebarHeader component
+type SidebarHeaderProps struct {
+	Class      string
+	Attributes templ.Attributes
+}
+
+// SidebarFooterProps defines the props for the SidebarFooter component
+type SidebarFooterProps struct {
+	Class      string
+	Attributes templ.Attributes
+}
+
+// SidebarContentProps defines the props for the scrolling SidebarContent area
+type SidebarContentProps struct {
+	Class      string
+	Attributes templ.Attributes
+}
+
+// SidebarSeparatorProps defines the props for the SidebarSeparator component
+type SidebarSeparatorProps struct {
+	Class      string
+	Attributes templ.Attributes
+}
+
+// SidebarGroupProps defines the props for the SidebarGroup component
+type SidebarGroupProps struct {
+	Class      string
+	Attributes templ.Attributes
+}
+
+// SidebarGroupLabelProps defines the props for the SidebarGroupLabel component
+type SidebarGroupLabelProps struct {
+	Class      string
+	Attributes templ.Attributes
+}
+
+// SidebarGroupContentProps defines the props for the SidebarGroupContent component
+type SidebarGroupContentProps struct {
+	Class      string
+	Attributes templ.Attributes
+}
+
+// SidebarGroupActionProps defines the props for the button beside a group label
+type SidebarGroupActionProps struct {
+	AriaLabel  string
+	Class      string
+	Attributes templ.Attributes
+}
+
+// SidebarMenuProps defines the props for the SidebarMenu list
+type SidebarMenuProps struct {
+	Class      string
+	Attributes templ.Attributes
+}
+
+// SidebarMenuItemProps defines the props for the SidebarMenuItem component
+type SidebarMenuItemProps struct {
+	Class      string
+	Attributes templ.Attributes
+}
+
+// SidebarMenuButtonProps defines the props for the SidebarMenuButton component
+type SidebarMenuButtonProps struct {
+	ID         string // Must match the SidebarProvider ID when Tooltip is set (default "sidebar")
+	Href       string // Renders a link instead of a button
+	IsActive   bool
+	Variant    string // "default" or "outline"
+	Size       string // "default", "sm" or "lg"
+	Tooltip    string // Shown on hover while the sidebar is collapsed to icons
+	Class      string
+	Attributes templ.Attributes
+}
+
+// SidebarMenuActionProps defines the props for the button at the end of a menu item
+type SidebarMenuActionProps struct {
+	AriaLabel   string
+	ShowOnHover bool // Only show the action while the menu item is hovered or focused (desktop)
+	Class       string
+	Attributes  templ.Attributes
+}
+
+// SidebarMenuBadgeProps defines the props for the count or label at the end of a menu item
+type SidebarMenuBadgeProps struct {
+	Class      string
+	Attributes templ.Attributes
+}
+
+// SidebarMenuSubProps defines the props for the SidebarMenuSub list
+type SidebarMenuSubProps struct {
+	Class      string
+	Attributes templ.Attributes
+}
+
+// SidebarMenuSubItemProps defines the props for the SidebarMenuSubItem component
+type SidebarMenuSubItemProps struct {
+	Class      string
+	Attributes templ.Attributes
+}
+
+// SidebarMenuSubButtonProps defines the props for the links of a sub-menu
+type SidebarMenuSubButtonProps struct {
+	Href       string
+	IsActive   bool
+	Size       string // "sm" or "md" (default)
+	Class      string
+	Attributes templ.Attributes
+}
+
+// SidebarMenuItemsProps defines the props for rendering SidebarItems as a menu
+type SidebarMenuItemsProps struct {
+	ID          string // Prefix for the sub-menu collapsible IDs; also the SidebarProvider ID for tooltips
+	Items       []SidebarItem
+	CurrentPath string
+}
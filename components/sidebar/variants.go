@@ -0,0 +1,188 @@
+package sidebar
+
+import "github.com/coreycole/datastarui/utils"
+
+// sidebarGapVariants generates the CSS classes for the in-flow spacer that reserves the sidebar's width on desktop
+func sidebarGapVariants(variant string) string {
+	iconWidth := "group-data-[collapsible=icon]:w-(--sidebar-width-icon)"
+	if variant == "floating" || variant == "inset" {
+		iconWidth = "group-data-[collapsible=icon]:w-[calc(var(--sidebar-width-icon)+1rem)]"
+	}
+	return "relative hidden w-(--sidebar-width) bg-transparent transition-[width] duration-200 ease-linear md:block " +
+		"group-data-[collapsible=offcanvas]:w-0 group-data-[side=right]:rotate-180 " + iconWidth
+}
+
+// sidebarContainerVariants generates the CSS classes for the fixed sidebar container
+// Below md it becomes a sheet sliding in over the page while the mobile state is open
+func sidebarContainerVariants(side, variant, className string) string {
+	classes := "fixed inset-y-0 z-50 flex h-svh w-(--sidebar-width) transition-[left,right,width,translate] duration-200 ease-linear md:z-10 " +
+		"max-md:w-(--sidebar-width-mobile) max-md:group-data-[mobile=open]:translate-x-0"
+	if side == "right" {
+		classes += " right-0 max-md:translate-x-full group-data-[collapsible=offcanvas]:right-[calc(var(--sidebar-width)*-1)]"
+	} else {
+		classes += " left-0 max-md:-translate-x-full group-data-[collapsible=offcanvas]:left-[calc(var(--sidebar-width)*-1)]"
+	}
+	if variant == "floating" || variant == "inset" {
+		classes += " p-2 group-data-[collapsible=icon]:w-[calc(var(--sidebar-width-icon)+1rem+2px)]"
+	} else {
+		classes += " group-data-[collapsible=icon]:w-(--sidebar-width-icon) group-data-[side=left]:border-r group-data-[side=right]:border-l"
+	}
+	return utils.TwMerge(classes, className)
+}
+
+// sidebarInnerVariants generates the CSS classes for the sidebar surface
+func sidebarInnerVariants() string {
+	return "bg-sidebar flex h-full w-full flex-col group-data-[variant=floating]:rounded-lg group-data-[variant=floating]:border " +
+		"group-data-[variant=floating]:border-sidebar-border group-data-[variant=floating]:shadow-sm"
+}
+
+// sidebarStaticVariants generates the CSS classes for a sidebar that cannot collapse
+func sidebarStaticVariants(className string) string {
+	return utils.TwMerge("bg-sidebar text-sidebar-foreground flex h-full w-(--sidebar-width) flex-col", className)
+}
+
+// sidebarTriggerVariants generates the CSS classes for the toggle button
+func sidebarTriggerVariants(className string) string {
+	return utils.TwMerge("size-7", className)
+}
+
+// sidebarRailVariants generates the CSS classes for the rail along the sidebar edge
+func sidebarRailVariants(className string) string {
+	return utils.TwMerge(
+		"absolute inset-y-0 z-20 hidden w-4 -translate-x-1/2 transition-all ease-linear sm:flex "+
+			"after:absolute after:inset-y-0 after:left-1/2 after:w-[2px] hover:after:bg-sidebar-border "+
+			"group-data-[side=left]:-right-4 group-data-[side=right]:left-0 "+
+			"group-data-[side=left]:cursor-w-resize group-data-[side=right]:cursor-e-resize "+
+			"[[data-side=left][data-state=collapsed]_&]:cursor-e-resize [[data-side=right][data-state=collapsed]_&]:cursor-w-resize "+
+			"group-data-[collapsible=offcanvas]:translate-x-0 group-data-[collapsible=offcanvas]:after:left-full hover:group-data-[collapsible=offcanvas]:bg-sidebar "+
+			"[[data-side=left][data-collapsible=offcanvas]_&]:-right-2 [[data-side=right][data-collapsible=offcanvas]_&]:-left-2",
+		className,
+	)
+}
+
+// sidebarInsetVariants generates the CSS classes for the main content beside the sidebar
+func sidebarInsetVariants(className string) string {
+	return utils.TwMerge(
+		"bg-background relative flex w-full flex-1 flex-col "+
+			"md:peer-data-[variant=inset]:m-2 md:peer-data-[variant=inset]:ml-0 md:peer-data-[variant=inset]:rounded-xl md:peer-data-[variant=inset]:shadow-sm "+
+			"md:peer-data-[variant=inset]:peer-data-[state=collapsed]:ml-2",
+		className,
+	)
+}
+
+// sidebarHeaderVariants generates the CSS classes for the sidebar header and footer
+func sidebarHeaderVariants(className string) string {
+	return utils.TwMerge("flex flex-col gap-2 p-2", className)
+}
+
+// sidebarSeparatorVariants generates the CSS classes for the separator
+func sidebarSeparatorVariants(className string) string {
+	return utils.TwMerge("bg-sidebar-border mx-2 h-px w-auto shrink-0", className)
+}
+
+// sidebarContentVariants generates the CSS classes for the scrolling content area
+func sidebarContentVariants(className string) string {
+	return utils.TwMerge("flex min-h-0 flex-1 flex-col gap-2 overflow-auto group-data-[collapsible=icon]:overflow-hidden", className)
+}
+
+// sidebarGroupVariants generates the CSS classes for a group
+func sidebarGroupVariants(className string) string {
+	return utils.TwMerge("relative flex w-full min-w-0 flex-col p-2", className)
+}
+
+// sidebarGroupLabelVariants generates the CSS classes for a group label, which slides away in icon mode
+func sidebarGroupLabelVariants(className string) string {
+	return utils.TwMerge(
+		"text-sidebar-foreground/70 ring-sidebar-ring flex h-8 shrink-0 items-center rounded-md px-2 text-xs font-medium outline-hidden "+
+			"transition-[margin,opacity] duration-200 ease-linear focus-visible:ring-2 [&>svg]:size-4 [&>svg]:shrink-0 "+
+			"group-data-[collapsible=icon]:-mt-8 group-data-[collapsible=icon]:opacity-0",
+		className,
+	)
+}
+
+// sidebarGroupActionVariants generates the CSS classes for a group action button
+func sidebarGroupActionVariants(className string) string {
+	return utils.TwMerge(
+		"text-sidebar-foreground ring-sidebar-ring hover:bg-sidebar-accent hover:text-sidebar-accent-foreground absolute top-3.5 right-3 "+
+			"flex aspect-square w-5 items-center justify-center rounded-md p-0 outline-hidden transition-transform focus-visible:ring-2 "+
+			"[&>svg]:size-4 [&>svg]:shrink-0 after:absolute after:-inset-2 md:after:hidden group-data-[collapsible=icon]:hidden",
+		className,
+	)
+}
+
+// sidebarMenuButtonVariants generates the CSS classes for a menu button
+func sidebarMenuButtonVariants(variant, size, className string) string {
+	baseClasses := "flex w-full items-center gap-2 overflow-hidden rounded-md p-2 text-left text-sm outline-hidden ring-sidebar-ring " +
+		"transition-[width,height,padding] hover:bg-sidebar-accent hover:text-sidebar-accent-foreground focus-visible:ring-2 " +
+		"active:bg-sidebar-accent active:text-sidebar-accent-foreground disabled:pointer-events-none disabled:opacity-50 " +
+		"aria-disabled:pointer-events-none aria-disabled:opacity-50 [&:has(~[data-slot=sidebar-menu-action])]:pr-8 " +
+		"data-[active=true]:bg-sidebar-accent data-[active=true]:font-medium data-[active=true]:text-sidebar-accent-foreground " +
+		"data-[state=open]:hover:bg-sidebar-accent data-[state=open]:hover:text-sidebar-accent-foreground " +
+		"group-data-[collapsible=icon]:!size-8 group-data-[collapsible=icon]:!p-2 [&>span:last-child]:truncate [&>svg]:size-4 [&>svg]:shrink-0"
+
+	var variantClasses string
+	switch variant {
+	case "outline":
+		variantClasses = "bg-background shadow-[0_0_0_1px_hsl(var(--sidebar-border))] hover:shadow-[0_0_0_1px_hsl(var(--sidebar-accent))]"
+	default:
+		variantClasses = ""
+	}
+
+	var sizeClasses string
+	switch size {
+	case "sm":
+		sizeClasses = "h-7 text-xs"
+	case "lg":
+		sizeClasses = "h-12 text-sm group-data-[collapsible=icon]:!p-0"
+	default:
+		sizeClasses = "h-8 text-sm"
+	}
+
+	return utils.TwMerge(baseClasses, variantClasses, sizeClasses, className)
+}
+
+// sidebarMenuActionVariants generates the CSS classes for a menu item action, placed against the button's size
+func sidebarMenuActionVariants(showOnHover bool, className string) string {
+	classes := "text-sidebar-foreground ring-sidebar-ring hover:bg-sidebar-accent hover:text-sidebar-accent-foreground absolute top-1.5 right-1 " +
+		"flex aspect-square w-5 items-center justify-center rounded-md p-0 outline-hidden transition-transform focus-visible:ring-2 " +
+		"[&>svg]:size-4 [&>svg]:shrink-0 after:absolute after:-inset-2 md:after:hidden " +
+		"[[data-size=sm]~&]:top-1 [[data-size=lg]~&]:top-2.5 group-data-[collapsible=icon]:hidden"
+	if showOnHover {
+		classes += " data-[state=open]:opacity-100 md:opacity-0 [[data-slot=sidebar-menu-item]:hover>&]:opacity-100 " +
+			"[[data-slot=sidebar-menu-item]:focus-within>&]:opacity-100 [[data-active=true]~&]:text-sidebar-accent-foreground"
+	}
+	return utils.TwMerge(classes, className)
+}
+
+// sidebarMenuBadgeVariants generates the CSS classes for a menu item badge
+func sidebarMenuBadgeVariants(className string) string {
+	return utils.TwMerge(
+		"text-sidebar-foreground pointer-events-none absolute top-1.5 right-1 flex h-5 min-w-5 items-center justify-center rounded-md px-1 "+
+			"text-xs font-medium tabular-nums select-none [[data-size=sm]~&]:top-1 [[data-size=lg]~&]:top-3.5 "+
+			"[[data-active=true]~&]:text-sidebar-accent-foreground group-data-[collapsible=icon]:hidden",
+		className,
+	)
+}
+
+// sidebarMenuSubVariants generates the CSS classes for a sub-menu list
+func sidebarMenuSubVariants(className string) string {
+	return utils.TwMerge(
+		"border-sidebar-border mx-3.5 flex min-w-0 translate-x-px flex-col gap-1 border-l px-2.5 py-0.5 group-data-[collapsible=icon]:hidden",
+		className,
+	)
+}
+
+// sidebarMenuSubButtonVariants generates the CSS classes for a sub-menu link
+func sidebarMenuSubButtonVariants(size, className string) string {
+	baseClasses := "text-sidebar-foreground ring-sidebar-ring hover:bg-sidebar-accent hover:text-sidebar-accent-foreground " +
+		"active:bg-sidebar-accent active:text-sidebar-accent-foreground flex h-7 min-w-0 -translate-x-px items-center gap-2 overflow-hidden " +
+		"rounded-md px-2 outline-hidden focus-visible:ring-2 aria-disabled:pointer-events-none aria-disabled:opacity-50 " +
+		"[&>span:last-child]:truncate [&>svg]:size-4 [&>svg]:shrink-0 [&>svg]:text-sidebar-accent-foreground " +
+		"data-[active=true]:bg-sidebar-accent data-[active=true]:text-sidebar-accent-foreground group-data-[collapsible=icon]:hidden"
+
+	sizeClasses := "text-sm"
+	if size == "sm" {
+		sizeClasses = "text-xs"
+	}
+	return utils.TwMerge(baseClasses, sizeClasses, className)
+}
@@ -0,0 +1,15 @@
+package skeleton
+
+import "github.com/a-h/templ"
+
+// SkeletonProps defines the properties for the Skeleton component
+type SkeletonProps struct {
+	// ID lets a server fragment with the same ID replace the placeholder once content loads
+	ID string
+
+	// Class allows additional CSS classes to be added (usually width, height and radius)
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
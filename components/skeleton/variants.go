@@ -0,0 +1,18 @@
+package skeleton
+
+import (
+	"github.com/coreycole/datastarui/utils"
+)
+
+// skeletonVariants generates the CSS classes for the Skeleton component
+func skeletonVariants(className string) string {
+	// Base classes from shadcn/ui New York v4
+	baseClasses := "bg-accent animate-pulse rounded-md"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
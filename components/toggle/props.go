@@ -0,0 +1,38 @@
+package toggle
+
+import "github.com/a-h/templ"
+
+// ToggleProps defines the properties for the Toggle component
+type ToggleProps struct {
+	// ID is a unique identifier for this toggle (required for signal management)
+	ID string
+
+	// Pressed sets the initial pressed state
+	Pressed bool
+
+	// Variant defines the visual style of the toggle
+	// Options: "default", "outline"
+	Variant string
+
+	// Size defines the size of the toggle
+	// Options: "default", "sm", "lg"
+	Size string
+
+	// Name submits Value with the form while pressed
+	Name string
+
+	// Value is the submitted value when pressed (default "on")
+	Value string
+
+	// Disabled makes the toggle non-interactive
+	Disabled bool
+
+	// AriaLabel names icon-only toggles for screen readers
+	AriaLabel string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
@@ -0,0 +1,48 @@
+package toggle
+
+import (
+	"github.com/coreycole/datastarui/utils"
+)
+
+// ToggleVariants generates the appropriate CSS classes for the toggle based on variant and size
+// Exported so togglegroup items share the same styles
+func ToggleVariants(variant, size, className string) string {
+	// Base classes that are always applied - exact copy from New York v4
+	baseClasses := "inline-flex items-center justify-center gap-2 rounded-md text-sm font-medium hover:bg-muted hover:text-muted-foreground disabled:pointer-events-none disabled:opacity-50 data-[state=on]:bg-accent data-[state=on]:text-accent-foreground [&_svg]:pointer-events-none [&_svg:not([class*='size-'])]:size-4 [&_svg]:shrink-0 focus-visible:border-ring focus-visible:ring-ring/50 focus-visible:ring-[3px] outline-none transition-[color,box-shadow] aria-invalid:ring-destructive/20 dark:aria-invalid:ring-destructive/40 aria-invalid:border-destructive whitespace-nowrap"
+
+	// Variant classes - exact copy from New York v4
+	variantClasses := map[string]string{
+		"default": "bg-transparent",
+		"outline": "border border-input bg-transparent shadow-xs hover:bg-accent hover:text-accent-foreground",
+	}
+
+	// Size classes - exact copy from New York v4
+	sizeClasses := map[string]string{
+		"default": "h-9 px-2 min-w-9",
+		"sm":      "h-8 px-1.5 min-w-8",
+		"lg":      "h-10 px-2.5 min-w-10",
+	}
+
+	// Set defaults if not provided
+	if variant == "" {
+		variant = "default"
+	}
+	if size == "" {
+		size = "default"
+	}
+
+	// Combine all classes
+	classes := []string{baseClasses}
+	if variantClass := variantClasses[variant]; variantClass != "" {
+		classes = append(classes, variantClass)
+	}
+	if sizeClass := sizeClasses[size]; sizeClass != "" {
+		classes = append(classes, sizeClass)
+	}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	// Use the utility function to merge classes (similar to cn() in shadcn/ui)
+	return utils.TwMerge(classes...)
+}
@@ -0,0 +1,63 @@
+package togglegroup
+
+import "github.com/a-h/templ"
+
+// ToggleGroupProps defines the properties for the ToggleGroup root component
+type ToggleGroupProps struct {
+	// ID is a unique identifier for this toggle group (required for signal management)
+	ID string
+
+	// Type selects how many items can be pressed: "single" (default) or "multiple"
+	Type string
+
+	// Value sets the initially pressed item for single groups
+	Value string
+
+	// Values sets the initially pressed items for multiple groups
+	Values []string
+
+	// Orientation sets the arrow keys used to move focus: "horizontal" (default) or "vertical"
+	Orientation string
+
+	// Variant defines the visual style of the group (match the items' Variant)
+	// Options: "default", "outline"
+	Variant string
+
+	// Name submits each pressed value as a hidden input with this name
+	Name string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// ToggleGroupItemProps defines the properties for the ToggleGroupItem component
+type ToggleGroupItemProps struct {
+	// ID is the toggle group identifier (required to link to the correct group)
+	ID string
+
+	// Value identifies this item in the group's value
+	Value string
+
+	// Variant defines the visual style of the item
+	// Options: "default", "outline"
+	Variant string
+
+	// Size defines the size of the item
+	// Options: "default", "sm", "lg"
+	Size string
+
+	// Disabled makes the item non-interactive and skips it during keyboard navigation
+	Disabled bool
+
+	// AriaLabel names icon-only items for screen readers
+	AriaLabel string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
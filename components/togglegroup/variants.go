@@ -0,0 +1,34 @@
+package togglegroup
+
+import (
+	"github.com/coreycole/datastarui/components/toggle"
+	"github.com/coreycole/datastarui/utils"
+)
+
+// toggleGroupVariants generates the CSS classes for the ToggleGroup root component
+func toggleGroupVariants(className string) string {
+	// Base classes from New York v4, plus the vertical layout
+	baseClasses := "group flex w-fit items-center rounded-md data-[variant=outline]:shadow-xs data-[orientation=vertical]:flex-col data-[orientation=vertical]:items-stretch"
+
+	classes := []string{baseClasses}
+	if className != "" {
+		classes = append(classes, className)
+	}
+
+	return utils.TwMerge(classes...)
+}
+
+// toggleGroupItemVariants generates the CSS classes for the ToggleGroupItem component
+// Items join into one control: only the outer corners stay rounded and shared borders collapse
+func toggleGroupItemVariants(variant, size, className string) string {
+	// Base classes from New York v4
+	groupClasses := "min-w-0 flex-1 shrink-0 rounded-none shadow-none focus:z-10 focus-visible:z-10 " +
+		"group-data-[orientation=horizontal]:first:rounded-l-md group-data-[orientation=horizontal]:last:rounded-r-md " +
+		"group-data-[orientation=vertical]:first:rounded-t-md group-data-[orientation=vertical]:last:rounded-b-md"
+	if variant == "outline" {
+		groupClasses += " group-data-[orientation=horizontal]:border-l-0 group-data-[orientation=horizontal]:first:border-l " +
+			"group-data-[orientation=vertical]:border-t-0 group-data-[orientation=vertical]:first:border-t"
+	}
+
+	return toggle.ToggleVariants(variant, size, utils.TwMerge(groupClasses, className))
+}
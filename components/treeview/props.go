@@ -0,0 +1,77 @@
+package treeview
+
+import "github.com/a-h/templ"
+
+// Node is one entry of the tree
+type Node struct {
+	// Value identifies the node in the selection and expanded state; it must be unique within the tree
+	Value string
+
+	// Label is the text shown for the node
+	Label string
+
+	// Icon is an optional icon shown before the label
+	Icon templ.Component
+
+	// Children are rendered nested beneath the node
+	Children []Node
+
+	// Lazy marks a node whose children are not known yet; expanding it the first time
+	// requests them from the tree's LoadURL
+	Lazy bool
+
+	// Disabled prevents the node from being selected
+	Disabled bool
+}
+
+// TreeViewProps defines the properties for the TreeView component
+type TreeViewProps struct {
+	// ID is a unique identifier for this tree (required for signal management)
+	ID string
+
+	// Nodes are the top level nodes
+	Nodes []Node
+
+	// Multiple allows selecting several nodes, shown with checkboxes
+	Multiple bool
+
+	// Value is the initially selected node for single selection
+	Value string
+
+	// Values are the initially selected nodes when Multiple
+	Values []string
+
+	// DefaultExpanded lists the nodes that start expanded
+	DefaultExpanded []string
+
+	// LoadURL is requested when a Lazy node is first expanded, with ?node=<value>&level=<level>
+	// where level is the aria-level of the children. The handler answers with a TreeGroup fragment
+	LoadURL string
+
+	// Name renders hidden inputs carrying the selected values for form submission
+	Name string
+
+	// AriaLabel labels the tree for assistive technology
+	AriaLabel string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
+
+// TreeGroupProps defines the properties for the TreeGroup component
+type TreeGroupProps struct {
+	// ID must match the parent TreeView ID
+	ID string
+
+	// Parent is the Value of the node the group belongs to
+	Parent string
+
+	// Level is the aria-level of the nodes in the group (1 for top level nodes)
+	Level int
+
+	// Nodes are the children of Parent
+	Nodes []Node
+}
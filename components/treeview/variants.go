@@ -0,0 +1,34 @@
+package treeview
+
+import "github.com/coreycole/datastarui/utils"
+
+// treeViewVariants generates the CSS classes for the TreeView root
+func treeViewVariants(className string) string {
+	return utils.TwMerge("flex flex-col gap-0.5 text-sm outline-none", className)
+}
+
+// treeItemRowVariants generates the CSS classes for the clickable row of a tree item;
+// focus and selection are styled from the enclosing treeitem so nested items are not affected
+func treeItemRowVariants() string {
+	return utils.TwMerge(
+		"flex h-8 cursor-default select-none items-center gap-1.5 rounded-md pr-2 transition-colors hover:bg-accent hover:text-accent-foreground",
+		"[[role=treeitem]:focus-visible>&]:ring-ring/50 [[role=treeitem]:focus-visible>&]:ring-[3px]",
+		"[[aria-selected=true]>&]:bg-accent [[aria-selected=true]>&]:font-medium [[aria-selected=true]>&]:text-accent-foreground",
+		"[[aria-disabled=true]>&]:pointer-events-none [[aria-disabled=true]>&]:opacity-50",
+		"[&_svg]:pointer-events-none [&_svg]:shrink-0 [&_svg:not([class*='size-'])]:size-4",
+	)
+}
+
+// treeItemToggleVariants generates the CSS classes for the expand/collapse chevron
+func treeItemToggleVariants() string {
+	return "flex size-5 items-center justify-center rounded-sm text-muted-foreground hover:text-foreground [&_svg]:transition-transform [[aria-expanded=true]>div>&_svg]:rotate-90"
+}
+
+// treeItemCheckboxVariants generates the CSS classes for the checkbox shown in multiple selection trees
+func treeItemCheckboxVariants() string {
+	return utils.TwMerge(
+		"hidden size-4 shrink-0 items-center justify-center rounded-[4px] border border-input shadow-xs [[aria-multiselectable=true]_&]:flex",
+		"[[aria-selected=true]>div>&]:border-primary [[aria-selected=true]>div>&]:bg-primary [[aria-selected=true]>div>&]:text-primary-foreground",
+		"[&_svg]:invisible [[aria-selected=true]>div>&_svg]:visible [&_svg]:size-3.5",
+	)
+}
@@ -0,0 +1,200 @@
+package upload
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// FieldName is the multipart field each file is posted under
+const FieldName = "file"
+
+// ErrNoFile is reported when an upload request carries no file
+var ErrNoFile = errors.New("no file received")
+
+// Result reports the outcome of receiving one file
+type Result struct {
+	Name  string
+	Size  int64
+	Value string // What save returned to identify the stored file
+	Err   error
+}
+
+// SaveFunc stores a validated file and returns a value identifying it
+type SaveFunc func(file *multipart.FileHeader) (string, error)
+
+// Handle receives one file posted by an Upload, validates it against props, stores it with save
+// and answers with the file's status fragment; failures are answered with 422 so the client marks
+// the file as errored. The result is returned for logging
+// Example: result, err := upload.Handle(c.Response(), c.Request(), props, save)
+func Handle(w http.ResponseWriter, r *http.Request, props UploadProps, save SaveFunc) (Result, error) {
+	if props.MaxSize > 0 {
+		// Leave headroom for the multipart framing around the file
+		r.Body = http.MaxBytesReader(w, r.Body, props.MaxSize+1<<20)
+	}
+
+	result := Result{Err: ErrNoFile}
+	if err := r.ParseMultipartForm(32 << 20); err == nil {
+		if files := r.MultipartForm.File[FieldName]; len(files) > 0 {
+			result = receive(props, files[0], save)
+		}
+	} else if props.MaxSize > 0 && errors.As(err, new(*http.MaxBytesError)) {
+		result.Err = errTooLarge(props.MaxSize)
+	}
+
+	if result.Err != nil {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	}
+	return result, FileStatus(props, result).Render(r.Context(), w)
+}
+
+// receive validates and stores a single file
+func receive(props UploadProps, file *multipart.FileHeader, save SaveFunc) Result {
+	result := Result{Name: file.Filename, Size: file.Size}
+	if result.Err = Validate(props, file); result.Err == nil {
+		result.Value, result.Err = save(file)
+	}
+	return result
+}
+
+// Validate checks a file against the type and size constraints of props, with the messages the client shows.
+// The type is sniffed from the file's first 512 bytes; the Content-Type the client sent is ignored
+func Validate(props UploadProps, file *multipart.FileHeader) error {
+	if props.Accept != "" {
+		contentType, err := sniff(file)
+		if err != nil {
+			return err
+		}
+		if !accepts(props.Accept, file.Filename, contentType) {
+			return errType(props.Accept)
+		}
+	}
+	if props.MaxSize > 0 && file.Size > props.MaxSize {
+		return errTooLarge(props.MaxSize)
+	}
+	return nil
+}
+
+// sniff detects the MIME type of a file from its content
+func sniff(file *multipart.FileHeader) (string, error) {
+	f, err := file.Open()
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(f, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(head[:n]))
+	return contentType, nil
+}
+
+// accepts reports whether a file name or its sniffed MIME type matches the accept list.
+// A name matches an extension pattern only when the content does not contradict the extension
+func accepts(accept, name, contentType string) bool {
+	patterns := acceptPatterns(accept)
+	if len(patterns) == 0 {
+		return true
+	}
+	ext := strings.ToLower(filepath.Ext(name))
+	for _, pattern := range patterns {
+		switch {
+		case strings.HasPrefix(pattern, "."):
+			if ext == pattern && matchesExt(ext, contentType) {
+				return true
+			}
+		case strings.HasSuffix(pattern, "/*"):
+			if strings.HasPrefix(contentType, strings.TrimSuffix(pattern, "*")) {
+				return true
+			}
+		case contentType == pattern:
+			return true
+		}
+	}
+	return false
+}
+
+// sniffedAliases maps extension types to the type their content is sniffed as
+var sniffedAliases = map[string]string{
+	"image/svg+xml": "text/xml",
+}
+
+// matchesExt reports whether content sniffed as contentType can be a file with extension ext.
+// Unrecognised binary content cannot contradict an extension; otherwise an image, audio, video
+// or PDF extension needs content of that type, and such content needs its own extension
+func matchesExt(ext, contentType string) bool {
+	extType, _, _ := mime.ParseMediaType(mime.TypeByExtension(ext))
+	switch {
+	case extType == "", contentType == extType, contentType == sniffedAliases[extType]:
+		return true
+	case contentType == "application/octet-stream":
+		return true
+	default:
+		return !isMedia(extType) && !isMedia(contentType)
+	}
+}
+
+// isMedia reports whether a MIME type is one http.DetectContentType recognises by signature
+func isMedia(contentType string) bool {
+	for _, prefix := range []string{"image/", "audio/", "video/", "application/pdf"} {
+		if strings.HasPrefix(contentType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// message renders err as the sentence shown to the user
+func message(err error) string {
+	s := err.Error()
+	r, n := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[n:]
+}
+
+// acceptPatterns splits an accept list into lowercase patterns
+func acceptPatterns(accept string) []string {
+	var patterns []string
+	for _, pattern := range strings.Split(accept, ",") {
+		if pattern = strings.ToLower(strings.TrimSpace(pattern)); pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return patterns
+}
+
+// formatSize renders a byte count the way the client does
+func formatSize(n int64) string {
+	switch {
+	case n < 1<<10:
+		return fmt.Sprintf("%d B", n)
+	case n < 1<<20:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	default:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	}
+}
+
+// errType reports a file whose type is not in the accept list
+func errType(accept string) error {
+	return fmt.Errorf("only %s files are accepted", strings.Join(acceptPatterns(accept), ", "))
+}
+
+// errTooLarge reports a file above the size limit
+func errTooLarge(maxSize int64) error {
+	return fmt.Errorf("file is larger than %s", formatSize(maxSize))
+}
+
+// errTooMany reports a file beyond the file limit
+func errTooMany(maxFiles int) error {
+	return fmt.Errorf("no more than %d files can be uploaded", maxFiles)
+}
@@ -0,0 +1,41 @@
+package upload
+
+import "github.com/a-h/templ"
+
+// UploadProps defines the properties for the Upload component. The handler receiving the files
+// should use the same props so Handle enforces the constraints the client checks
+type UploadProps struct {
+	// ID is a unique identifier for this upload (required for signal management)
+	ID string
+
+	// URL receives each file in its own multipart POST, under FieldName; answer it with Handle
+	URL string
+
+	// Name submits the value Handle's save function returns for each stored file as a hidden
+	// input with this name, so an enclosing form can reference the uploads
+	Name string
+
+	// Accept lists accepted extensions and MIME types like the input accept attribute (e.g. "image/*,.pdf")
+	Accept string
+
+	// MaxSize is the largest accepted file in bytes (0 for no limit)
+	MaxSize int64
+
+	// MaxFiles is the most files the upload holds at once (0 for no limit)
+	MaxFiles int
+
+	// Multiple allows choosing several files at once
+	Multiple bool
+
+	// Disabled prevents adding files
+	Disabled bool
+
+	// Description replaces the hint shown in the dropzone
+	Description string
+
+	// Class allows additional CSS classes to be added
+	Class string
+
+	// Attributes allows additional HTML attributes to be added
+	Attributes templ.Attributes
+}
@@ -0,0 +1,29 @@
+package upload
+
+import "github.com/coreycole/datastarui/utils"
+
+// uploadDropzoneVariants generates the CSS classes for the drop target
+func uploadDropzoneVariants(className string) string {
+	return utils.TwMerge(
+		"flex cursor-pointer flex-col items-center justify-center gap-2 rounded-lg border-2 border-dashed border-input px-6 py-10 text-center text-sm transition-colors outline-none",
+		"hover:bg-accent/50 focus-visible:border-ring focus-visible:ring-ring/50 focus-visible:ring-[3px]",
+		"data-[dragging]:border-primary data-[dragging]:bg-accent",
+		"aria-disabled:pointer-events-none aria-disabled:opacity-50",
+		className,
+	)
+}
+
+// uploadFileVariants generates the CSS classes for a row of the file list
+func uploadFileVariants() string {
+	return "flex items-center gap-3 rounded-md border p-2 text-sm data-[state=error]:border-destructive/50"
+}
+
+// uploadProgressVariants generates the CSS classes for a file's progress track, hidden once the upload ends
+func uploadProgressVariants() string {
+	return "mt-1.5 h-1 w-full overflow-hidden rounded-full bg-primary/20 [[data-slot=upload-file]:not([data-state=uploading])_&]:hidden"
+}
+
+// fileStatusVariants generates the CSS classes for a file's status line
+func fileStatusVariants() string {
+	return "mt-0.5 text-xs text-muted-foreground empty:hidden [[data-slot=upload-file][data-state=error]_&]:text-destructive"
+}
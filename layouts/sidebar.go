@@ -1,22 +1,69 @@
 package layouts
 
-import "github.com/coreycole/datastarui/components/sidebar"
+import (
+	"context"
+	"net/http"
 
-func getSidebarSections() []sidebar.SidebarSection {
-	return []sidebar.SidebarSection{
+	"github.com/coreycole/datastarui/components/sidebar"
+)
+
+// SidebarID is the SidebarProvider ID of the docs layout, naming its state cookie
+const SidebarID = "docs-sidebar"
+
+type sidebarStateKey struct{}
+
+// WithSidebarState returns r with the docs sidebar state saved by the client's last toggle in its
+// context, so Root renders the sidebar collapsed or expanded without a flash
+func WithSidebarState(r *http.Request) *http.Request {
+	return r.WithContext(context.WithValue(r.Context(), sidebarStateKey{}, sidebar.LoadCollapsed(r, SidebarID)))
+}
+
+// sidebarCollapsed reports whether the docs sidebar renders collapsed (see WithSidebarState)
+func sidebarCollapsed(ctx context.Context) bool {
+	collapsed, _ := ctx.Value(sidebarStateKey{}).(bool)
+	return collapsed
+}
+
+// getSidebarItems returns the docs navigation; the component pages form a sub-menu that is open
+// while one of them is showing
+func getSidebarItems() []sidebar.SidebarItem {
+	return []sidebar.SidebarItem{
+		{Title: "Docs", Href: "/docs", Icon: docsIcon()},
 		{
 			Title: "Components",
+			Href:  "/components",
+			Icon:  componentsIcon(),
 			Items: []sidebar.SidebarItem{
+				{Title: "Alert", Href: "/components/alert"},
+				{Title: "Avatar", Href: "/components/avatar"},
+				{Title: "Badge", Href: "/components/badge"},
 				{Title: "Breadcrumb", Href: "/components/breadcrumb"},
 				{Title: "Button", Href: "/components/button"},
 				{Title: "Card", Href: "/components/card"},
+				{Title: "Carousel", Href: "/components/carousel"},
+				{Title: "Chart", Href: "/components/chart"},
 				{Title: "Checkbox", Href: "/components/checkbox"},
+				{Title: "Collapsible", Href: "/components/collapsible"},
+				{Title: "Context Menu", Href: "/components/context-menu"},
 				{Title: "Dialog", Href: "/components/dialog"},
+				{Title: "Drawer", Href: "/components/drawer"},
 				{Title: "Dropdown", Href: "/components/dropdown"},
 				{Title: "Form", Href: "/components/form"},
+				{Title: "Hover Card", Href: "/components/hover-card"},
+				{Title: "Menubar", Href: "/components/menubar"},
+				{Title: "Navigation Menu", Href: "/components/navigation-menu"},
 				{Title: "Popover", Href: "/components/popover"},
+				{Title: "Progress", Href: "/components/progress"},
+				{Title: "Resizable", Href: "/components/resizable"},
+				{Title: "Scroll Area", Href: "/components/scroll-area"},
 				{Title: "Select", Href: "/components/select"},
+				{Title: "Sidebar", Href: "/components/sidebar"},
+				{Title: "Skeleton", Href: "/components/skeleton"},
 				{Title: "Tabs", Href: "/components/tabs"},
+				{Title: "Toggle", Href: "/components/toggle"},
+				{Title: "Toggle Group", Href: "/components/toggle-group"},
+				{Title: "Tree View", Href: "/components/tree-view"},
+				{Title: "Upload", Href: "/components/upload"},
 			},
 		},
 	}
@@ -7,17 +7,40 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
+	"github.com/coreycole/datastarui/components/resizable"
+	"github.com/coreycole/datastarui/components/sidebar"
+	"github.com/coreycole/datastarui/layouts"
 	p "github.com/coreycole/datastarui/pages"
+	"github.com/coreycole/datastarui/pages/components/alertpage"
+	"github.com/coreycole/datastarui/pages/components/avatarpage"
+	"github.com/coreycole/datastarui/pages/components/badgepage"
 	"github.com/coreycole/datastarui/pages/components/breadcrumbpage"
 	"github.com/coreycole/datastarui/pages/components/buttonpage"
 	"github.com/coreycole/datastarui/pages/components/cardpage"
+	"github.com/coreycole/datastarui/pages/components/carouselpage"
+	"github.com/coreycole/datastarui/pages/components/chartpage"
 	"github.com/coreycole/datastarui/pages/components/checkboxpage"
+	"github.com/coreycole/datastarui/pages/components/collapsiblepage"
+	"github.com/coreycole/datastarui/pages/components/contextmenupage"
 	"github.com/coreycole/datastarui/pages/components/dialogpage"
+	"github.com/coreycole/datastarui/pages/components/drawerpage"
 	"github.com/coreycole/datastarui/pages/components/dropdownpage"
 	"github.com/coreycole/datastarui/pages/components/formpage"
+	"github.com/coreycole/datastarui/pages/components/hovercardpage"
+	"github.com/coreycole/datastarui/pages/components/menubarpage"
+	"github.com/coreycole/datastarui/pages/components/navigationmenupage"
 	"github.com/coreycole/datastarui/pages/components/popoverpage"
+	"github.com/coreycole/datastarui/pages/components/progresspage"
+	"github.com/coreycole/datastarui/pages/components/resizablepage"
+	"github.com/coreycole/datastarui/pages/components/scrollareapage"
 	"github.com/coreycole/datastarui/pages/components/selectpage"
+	"github.com/coreycole/datastarui/pages/components/sidebarpage"
+	"github.com/coreycole/datastarui/pages/components/skeletonpage"
 	"github.com/coreycole/datastarui/pages/components/tabspage"
+	"github.com/coreycole/datastarui/pages/components/togglegrouppage"
+	"github.com/coreycole/datastarui/pages/components/togglepage"
+	"github.com/coreycole/datastarui/pages/components/treeviewpage"
+	"github.com/coreycole/datastarui/pages/components/uploadpage"
 )
 
 const port = "4242"
@@ -31,6 +54,14 @@ func main() {
 	e.Use(middleware.Recover())
 	e.Use(middleware.CORS())
 
+	// Render the docs sidebar in the state saved by its last toggle
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.SetRequest(layouts.WithSidebarState(c.Request()))
+			return next(c)
+		}
+	})
+
 	// Serve the home page at the root route
 	e.GET("/", func(c echo.Context) error {
 		component := p.HomePage()
@@ -71,6 +102,73 @@ func main() {
 	e.GET("/components/select", func(c echo.Context) error {
 		return selectpage.SelectPage().Render(c.Request().Context(), c.Response().Writer)
 	})
+	e.GET("/components/collapsible", func(c echo.Context) error {
+		return collapsiblepage.CollapsiblePage().Render(c.Request().Context(), c.Response().Writer)
+	})
+	e.GET("/components/context-menu", func(c echo.Context) error {
+		return contextmenupage.ContextMenuPage().Render(c.Request().Context(), c.Response().Writer)
+	})
+	e.GET("/components/hover-card", func(c echo.Context) error {
+		return hovercardpage.HoverCardPage().Render(c.Request().Context(), c.Response().Writer)
+	})
+	e.GET("/components/menubar", func(c echo.Context) error {
+		return menubarpage.MenubarPage().Render(c.Request().Context(), c.Response().Writer)
+	})
+	e.GET("/components/navigation-menu", func(c echo.Context) error {
+		return navigationmenupage.NavigationMenuPage().Render(c.Request().Context(), c.Response().Writer)
+	})
+	e.GET("/components/progress", func(c echo.Context) error {
+		return progresspage.ProgressPage().Render(c.Request().Context(), c.Response().Writer)
+	})
+	e.GET("/components/skeleton", func(c echo.Context) error {
+		return skeletonpage.SkeletonPage().Render(c.Request().Context(), c.Response().Writer)
+	})
+	e.GET("/components/alert", func(c echo.Context) error {
+		return alertpage.AlertPage().Render(c.Request().Context(), c.Response().Writer)
+	})
+	e.GET("/components/avatar", func(c echo.Context) error {
+		return avatarpage.AvatarPage().Render(c.Request().Context(), c.Response().Writer)
+	})
+	e.GET("/components/badge", func(c echo.Context) error {
+		return badgepage.BadgePage().Render(c.Request().Context(), c.Response().Writer)
+	})
+	e.GET("/components/toggle", func(c echo.Context) error {
+		return togglepage.TogglePage().Render(c.Request().Context(), c.Response().Writer)
+	})
+	e.GET("/components/toggle-group", func(c echo.Context) error {
+		return togglegrouppage.ToggleGroupPage().Render(c.Request().Context(), c.Response().Writer)
+	})
+	e.GET("/components/scroll-area", func(c echo.Context) error {
+		return scrollareapage.ScrollAreaPage().Render(c.Request().Context(), c.Response().Writer)
+	})
+	e.GET("/components/carousel", func(c echo.Context) error {
+		return carouselpage.CarouselPage().Render(c.Request().Context(), c.Response().Writer)
+	})
+	e.GET("/components/chart", func(c echo.Context) error {
+		return chartpage.ChartPage().Render(c.Request().Context(), c.Response().Writer)
+	})
+	e.GET("/components/drawer", func(c echo.Context) error {
+		return drawerpage.DrawerPage().Render(c.Request().Context(), c.Response().Writer)
+	})
+	e.GET("/components/sidebar", func(c echo.Context) error {
+		return sidebarpage.SidebarPage().Render(c.Request().Context(), c.Response().Writer)
+	})
+	e.GET(sidebarpage.DemoPath, func(c echo.Context) error {
+		// Render the sidebar in the state saved by its last toggle
+		collapsed := sidebar.LoadCollapsed(c.Request(), "")
+		return sidebarpage.SidebarDemo(collapsed).Render(c.Request().Context(), c.Response().Writer)
+	})
+	e.GET("/components/tree-view", func(c echo.Context) error {
+		return treeviewpage.TreeViewPage().Render(c.Request().Context(), c.Response().Writer)
+	})
+	e.GET("/components/upload", func(c echo.Context) error {
+		return uploadpage.UploadPage().Render(c.Request().Context(), c.Response().Writer)
+	})
+	e.GET("/components/resizable", func(c echo.Context) error {
+		// Render the persisted panel group with its saved layout
+		layout := resizable.LoadLayout(c.Request(), resizablepage.PersistedLayoutID)
+		return resizablepage.ResizablePage(layout).Render(c.Request().Context(), c.Response().Writer)
+	})
 
 	// Serve the docs page
 	e.GET("/docs", func(c echo.Context) error {
@@ -85,7 +183,17 @@ func main() {
 	// Register form demo handlers
 	formpage.RegisterFormPageHandlers(e)
 	checkboxpage.RegisterCheckboxHandlers(e)
+	carouselpage.RegisterCarouselPageHandlers(e)
+	chartpage.RegisterChartPageHandlers(e)
 	dialogpage.RegisterDialogPageHandlers(e)
+	dropdownpage.RegisterDropdownPageHandlers(e)
+	hovercardpage.RegisterHoverCardPageHandlers(e)
+	progresspage.RegisterProgressPageHandlers(e)
+	selectpage.RegisterSelectPageHandlers(e)
+	skeletonpage.RegisterSkeletonPageHandlers(e)
+	togglegrouppage.RegisterToggleGroupPageHandlers(e)
+	treeviewpage.RegisterTreeViewPageHandlers(e)
+	uploadpage.RegisterUploadPageHandlers(e)
 
 	// Serve static files
 	e.Static("/", "static/")
@@ -0,0 +1,48 @@
+package carouselpage
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	datastar "github.com/starfederation/datastar/sdk/go"
+
+	"github.com/coreycole/datastarui/components/carousel"
+)
+
+const (
+	// pageSize is the number of slides rendered or appended at a time
+	pageSize = 4
+	// totalSlides is the number of slides the load-more demo can serve
+	totalSlides = 16
+)
+
+// RegisterCarouselPageHandlers registers all carousel demo route handlers
+func RegisterCarouselPageHandlers(e *echo.Echo) {
+	// Load more - appends the next page of slides to the track
+	e.GET("/carousel/carousel-page/slides", func(c echo.Context) error {
+		sse := datastar.NewSSE(c.Response().Writer, c.Request())
+
+		offset, err := strconv.Atoi(c.QueryParam("offset"))
+		if err != nil || offset < 0 {
+			offset = 0
+		}
+		count := max(min(pageSize, totalSlides-offset), 0)
+
+		// Simulate a slow backend so the loading state is visible
+		time.Sleep(500 * time.Millisecond)
+
+		if count > 0 {
+			sse.MergeFragmentTempl(
+				Slides(offset, count),
+				datastar.WithSelectorID(carousel.TrackID("load-more-carousel")),
+				datastar.WithMergeAppend(),
+			)
+		}
+		sse.MarshalAndMergeSignals(carousel.LoadedUpdate("load-more-carousel", offset+count < totalSlides))
+
+		log.Printf("Carousel loaded slides %d-%d", offset+1, offset+count)
+		return nil
+	})
+}
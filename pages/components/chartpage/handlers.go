@@ -0,0 +1,67 @@
+package chartpage
+
+import (
+	"context"
+	"log"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	datastar "github.com/starfederation/datastar/sdk/go"
+)
+
+// liveWindow is the number of samples the live chart shows
+const liveWindow = 30
+
+// RegisterChartPageHandlers registers all chart demo route handlers
+func RegisterChartPageHandlers(e *echo.Echo) {
+	// Live chart - holds the SSE connection open and re-renders the chart for every new sample
+	e.GET("/chart/chart-page/live", func(c echo.Context) error {
+		sse := datastar.NewSSE(c.Response().Writer, c.Request())
+
+		samples := sampleLoad(sse.Context(), time.Second)
+		var window []float64
+		for {
+			select {
+			case <-sse.Context().Done():
+				// Client went away - the sampler stops with the same context
+				log.Printf("Live chart stream closed after %d samples", len(window))
+				return nil
+			case sample := <-samples:
+				window = append(window, sample)
+				if len(window) > liveWindow {
+					window = window[len(window)-liveWindow:]
+				}
+				if err := sse.MergeFragmentTempl(LiveChart(window)); err != nil {
+					return err
+				}
+			}
+		}
+	})
+}
+
+// sampleLoad simulates a load sensor, sending a reading on every tick until ctx is done
+func sampleLoad(ctx context.Context, interval time.Duration) <-chan float64 {
+	samples := make(chan float64)
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		load := 40.0
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				load = math.Min(math.Max(load+rand.Float64()*20-10, 0), 100)
+				select {
+				case samples <- math.Round(load):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return samples
+}
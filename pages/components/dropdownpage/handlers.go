@@ -0,0 +1,65 @@
+package dropdownpage
+
+import (
+	"log"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/labstack/echo/v4"
+	datastar "github.com/starfederation/datastar/sdk/go"
+)
+
+// nextFileID numbers the files created by the duplicate action, after the three rendered by the page
+var nextFileID atomic.Int64
+
+func init() {
+	nextFileID.Store(3)
+}
+
+// RegisterDropdownPageHandlers registers all dropdown demo route handlers
+func RegisterDropdownPageHandlers(e *echo.Echo) {
+	// View options form - each checked item arrives as a repeated "panels" value
+	e.POST("/dropdown/dropdown-page/view", func(c echo.Context) error {
+		if err := c.Request().ParseMultipartForm(32 << 20); err != nil {
+			log.Printf("Error parsing form: %v", err)
+		}
+
+		params, _ := c.FormParams()
+		panels := params["panels"]
+		position := c.FormValue("position")
+		log.Printf("View form submitted - Panels: %v, Position: %s", panels, position)
+
+		summary := "no panels"
+		if len(panels) > 0 {
+			summary = strings.Join(panels, ", ")
+		}
+
+		sse := datastar.NewSSE(c.Response().Writer, c.Request())
+		sse.MergeFragmentTempl(viewResult(summary + "; panel at " + position))
+		return nil
+	})
+
+	// Duplicate action - appends a copy of the file to the list
+	e.POST("/dropdown/dropdown-page/files/:id/duplicate", func(c echo.Context) error {
+		name := "Copy of " + c.QueryParam("name")
+		id := strconv.FormatInt(nextFileID.Add(1)-1, 10)
+		log.Printf("File %s duplicated as %s", c.Param("id"), id)
+
+		sse := datastar.NewSSE(c.Response().Writer, c.Request())
+		sse.MergeFragmentTempl(fileRow(id, name), datastar.WithSelectorID("file-list"), datastar.WithMergeAppend())
+		sse.MarshalAndMergeSignals(map[string]any{"fileStatus": "Created " + name})
+		return nil
+	})
+
+	// Delete action - sent once the confirmation is accepted, removes the file's row
+	e.DELETE("/dropdown/dropdown-page/files/:id", func(c echo.Context) error {
+		id := c.Param("id")
+		log.Printf("File %s deleted", id)
+
+		sse := datastar.NewSSE(c.Response().Writer, c.Request())
+		sse.RemoveFragments("#file-" + id)
+		sse.MarshalAndMergeSignals(map[string]any{"fileStatus": "Deleted " + c.QueryParam("name")})
+		return nil
+	})
+}
@@ -0,0 +1,59 @@
+package hovercardpage
+
+import (
+	"log"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	datastar "github.com/starfederation/datastar/sdk/go"
+
+	"github.com/coreycole/datastarui/components/hovercard"
+)
+
+// profile is the demo data shown in the lazy hover card
+type profile struct {
+	Handle   string
+	Name     string
+	Bio      string
+	Joined   string
+	Initials string
+}
+
+var profiles = map[string]profile{
+	"datastar": {
+		Handle:   "@datastar",
+		Name:     "Datastar",
+		Bio:      "The hypermedia framework. Backend-driven reactivity in a 15KB runtime.",
+		Joined:   "Joined June 2024",
+		Initials: "DS",
+	},
+	"templ": {
+		Handle:   "@templ",
+		Name:     "templ",
+		Bio:      "A language for writing HTML user interfaces in Go.",
+		Joined:   "Joined May 2021",
+		Initials: "TP",
+	},
+}
+
+// RegisterHoverCardPageHandlers registers all hover card demo route handlers
+func RegisterHoverCardPageHandlers(e *echo.Echo) {
+	// Lazy profile preview - requested the first time a card opens
+	e.GET("/hovercard/hovercard-page/profile/:handle", func(c echo.Context) error {
+		handle := c.Param("handle")
+		p, ok := profiles[handle]
+
+		// Simulate a slow lookup so the loading placeholder is visible
+		time.Sleep(500 * time.Millisecond)
+
+		sse := datastar.NewSSE(c.Response().Writer, c.Request())
+		if !ok {
+			sse.MergeFragmentTempl(profileNotFound(hovercard.BodyID("lazy-"+handle), handle))
+			return nil
+		}
+
+		log.Printf("Hover card profile loaded - Handle: %s", handle)
+		sse.MergeFragmentTempl(profilePreview(hovercard.BodyID("lazy-"+handle), p))
+		return nil
+	})
+}
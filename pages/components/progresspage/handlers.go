@@ -0,0 +1,49 @@
+package progresspage
+
+import (
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	datastar "github.com/starfederation/datastar/sdk/go"
+
+	"github.com/coreycole/datastarui/components/progress"
+)
+
+// RegisterProgressPageHandlers registers all progress demo route handlers
+func RegisterProgressPageHandlers(e *echo.Echo) {
+	// Simulated upload - holds the SSE connection open and streams the percentage
+	e.GET("/progress/progress-page/upload", func(c echo.Context) error {
+		sse := datastar.NewSSE(c.Response().Writer, c.Request())
+
+		sse.MarshalAndMergeSignals(map[string]any{
+			"progress_demo": map[string]any{"running": true},
+		})
+		sse.MarshalAndMergeSignals(progress.ValueUpdate("upload-progress", 0))
+		sse.MarshalAndMergeSignals(progress.ValueUpdate("upload-progress-circular", 0))
+
+		ticker := time.NewTicker(150 * time.Millisecond)
+		defer ticker.Stop()
+
+		for value := 0; value < 100; {
+			select {
+			case <-sse.Context().Done():
+				// Client went away - stop working
+				log.Printf("Progress upload cancelled at %d%%", value)
+				return nil
+			case <-ticker.C:
+				value = min(value+rand.Intn(8)+1, 100)
+				sse.MarshalAndMergeSignals(progress.ValueUpdate("upload-progress", value))
+				sse.MarshalAndMergeSignals(progress.ValueUpdate("upload-progress-circular", value))
+			}
+		}
+
+		sse.MarshalAndMergeSignals(map[string]any{
+			"progress_demo": map[string]any{"running": false},
+		})
+
+		log.Printf("Progress upload completed")
+		return nil
+	})
+}
@@ -0,0 +1,115 @@
+package selectpage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	datastar "github.com/starfederation/datastar/sdk/go"
+
+	selectui "github.com/coreycole/datastarui/components/select"
+)
+
+const (
+	// cityPageSize is the number of cities sent per page
+	cityPageSize = 25
+	// totalCities is the number of cities the server-loaded demo can serve
+	totalCities = 1000
+)
+
+// cityName returns the display name of the nth demo city
+func cityName(n int) string {
+	return fmt.Sprintf("City %04d", n)
+}
+
+// cities is the demo OptionSource, matching names that contain the query
+var cities = selectui.OptionSourceFunc(func(ctx context.Context, query string, offset, limit int) ([]selectui.SelectOption, bool, error) {
+	var matches []selectui.SelectOption
+	for n := 1; n <= totalCities; n++ {
+		if strings.Contains(strings.ToLower(cityName(n)), strings.ToLower(query)) {
+			matches = append(matches, selectui.SelectOption{Value: fmt.Sprintf("city-%d", n), Label: cityName(n)})
+		}
+	}
+	if offset >= len(matches) {
+		return nil, false, nil
+	}
+	end := min(offset+limit, len(matches))
+	return matches[offset:end], end < len(matches), nil
+})
+
+// RegisterSelectPageHandlers registers all select demo route handlers
+func RegisterSelectPageHandlers(e *echo.Echo) {
+	// Server-loaded options - appends the next page of cities matching the search
+	e.GET("/select/select-page/cities", func(c echo.Context) error {
+		// Simulate a slow backend so the loading row is visible
+		time.Sleep(300 * time.Millisecond)
+
+		page, err := selectui.LoadOptions(c.Request(), "remote_city_select", cities, cityPageSize)
+		if err != nil {
+			log.Printf("Error loading cities: %v", err)
+		}
+
+		sse := datastar.NewSSE(c.Response().Writer, c.Request())
+		sse.MergeFragmentTempl(
+			page.Items,
+			datastar.WithSelectorID(selectui.OptionsListID("remote_city_select")),
+			datastar.WithMergeAppend(),
+		)
+		return nil
+	})
+
+	// Dependent selects - re-render the state select for the chosen country
+	e.GET("/select/select-page/location/states", func(c echo.Context) error {
+		country := selectui.DependsValue(c.Request())
+
+		sse := datastar.NewSSE(c.Response().Writer, c.Request())
+		sse.MergeFragmentTempl(stateSelect(country), datastar.WithSelector(selectui.Selector("location_state_select")))
+		return nil
+	})
+
+	// Dependent selects - re-render the city select for the chosen state
+	e.GET("/select/select-page/location/cities", func(c echo.Context) error {
+		state := selectui.DependsValue(c.Request())
+
+		sse := datastar.NewSSE(c.Response().Writer, c.Request())
+		sse.MergeFragmentTempl(citySelect(state), datastar.WithSelector(selectui.Selector("location_city_select")))
+		return nil
+	})
+
+	// Dependent selects form
+	e.POST("/select/select-page/location", func(c echo.Context) error {
+		if err := c.Request().ParseMultipartForm(32 << 20); err != nil {
+			log.Printf("Error parsing form: %v", err)
+		}
+
+		country, state, city := c.FormValue("country"), c.FormValue("state"), c.FormValue("city")
+		log.Printf("Location form submitted - Country: %s, State: %s, City: %s", country, state, city)
+
+		sse := datastar.NewSSE(c.Response().Writer, c.Request())
+		sse.MergeFragmentTempl(locationResult(strings.Join([]string{country, state, city}, " / ")))
+		return nil
+	})
+
+	// Multiple selection form - each selected value arrives as a repeated form value
+	e.POST("/select/select-page/multiple", func(c echo.Context) error {
+		if err := c.Request().ParseMultipartForm(32 << 20); err != nil {
+			log.Printf("Error parsing form: %v", err)
+		}
+
+		params, _ := c.FormParams()
+		frameworks := params["frameworks"]
+		log.Printf("Select form submitted - Frameworks: %v", frameworks)
+
+		summary := "none"
+		if len(frameworks) > 0 {
+			summary = strings.Join(frameworks, ", ")
+		}
+
+		sse := datastar.NewSSE(c.Response().Writer, c.Request())
+		sse.MergeFragmentTempl(multipleResult(summary))
+		return nil
+	})
+}
@@ -0,0 +1,69 @@
+package selectpage
+
+import (
+	selectui "github.com/coreycole/datastarui/components/select"
+)
+
+// location is a demo place with the places below it
+type location struct {
+	value    string
+	label    string
+	children []location
+}
+
+// locations is the country → state → city tree behind the dependent selects demo
+var locations = []location{
+	{"us", "United States", []location{
+		{"ca", "California", []location{{"la", "Los Angeles", nil}, {"sf", "San Francisco", nil}, {"sd", "San Diego", nil}}},
+		{"ny", "New York", []location{{"nyc", "New York City", nil}, {"buf", "Buffalo", nil}}},
+		{"tx", "Texas", []location{{"aus", "Austin", nil}, {"hou", "Houston", nil}, {"dal", "Dallas", nil}}},
+	}},
+	{"ca", "Canada", []location{
+		{"on", "Ontario", []location{{"tor", "Toronto", nil}, {"ott", "Ottawa", nil}}},
+		{"qc", "Quebec", []location{{"mtl", "Montreal", nil}, {"qcc", "Quebec City", nil}}},
+	}},
+	{"au", "Australia", []location{
+		{"nsw", "New South Wales", []location{{"syd", "Sydney", nil}, {"new", "Newcastle", nil}}},
+		{"vic", "Victoria", []location{{"mel", "Melbourne", nil}, {"gee", "Geelong", nil}}},
+	}},
+}
+
+// findLocation returns the location with the given value among places
+func findLocation(places []location, value string) (location, bool) {
+	for _, place := range places {
+		if place.value == value {
+			return place, true
+		}
+	}
+	return location{}, false
+}
+
+// locationOptions converts places to select options
+func locationOptions(places []location) []selectui.SelectOption {
+	options := make([]selectui.SelectOption, 0, len(places))
+	for _, place := range places {
+		options = append(options, selectui.SelectOption{Value: place.value, Label: place.label})
+	}
+	return options
+}
+
+// countryOptions returns the options of the country select
+func countryOptions() []selectui.SelectOption {
+	return locationOptions(locations)
+}
+
+// stateOptions returns the states of a country
+func stateOptions(country string) []selectui.SelectOption {
+	c, _ := findLocation(locations, country)
+	return locationOptions(c.children)
+}
+
+// cityOptions returns the cities of a state, in whichever country it is
+func cityOptions(state string) []selectui.SelectOption {
+	for _, c := range locations {
+		if s, ok := findLocation(c.children, state); ok {
+			return locationOptions(s.children)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package skeletonpage
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+	datastar "github.com/starfederation/datastar/sdk/go"
+)
+
+// RegisterSkeletonPageHandlers registers all skeleton demo route handlers
+func RegisterSkeletonPageHandlers(e *echo.Echo) {
+	// Profile card - replaces the skeleton placeholder with the same element ID
+	e.GET("/skeleton/skeleton-page/profile", func(c echo.Context) error {
+		// Simulate a slow lookup so the placeholder is visible
+		time.Sleep(1500 * time.Millisecond)
+
+		sse := datastar.NewSSE(c.Response().Writer, c.Request())
+		sse.MergeFragmentTempl(profileCard())
+		return nil
+	})
+}
@@ -0,0 +1,36 @@
+package togglegrouppage
+
+import (
+	"log"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	datastar "github.com/starfederation/datastar/sdk/go"
+)
+
+// RegisterToggleGroupPageHandlers registers all toggle group demo route handlers
+func RegisterToggleGroupPageHandlers(e *echo.Echo) {
+	// Preferences form - the groups submit their pressed values as hidden inputs
+	e.POST("/togglegroup/togglegroup-page/preferences", func(c echo.Context) error {
+		if err := c.Request().ParseMultipartForm(32 << 20); err != nil {
+			log.Printf("Error parsing form: %v", err)
+		}
+
+		alignment := c.FormValue("alignment")
+		formatting := c.Request().Form["formatting"]
+
+		log.Printf("Toggle group form submitted - Alignment: %s, Formatting: %v", alignment, formatting)
+
+		if alignment == "" {
+			alignment = "none"
+		}
+		summary := "none"
+		if len(formatting) > 0 {
+			summary = strings.Join(formatting, ", ")
+		}
+
+		sse := datastar.NewSSE(c.Response().Writer, c.Request())
+		sse.MergeFragmentTempl(preferencesResult(alignment, summary))
+		return nil
+	})
+}
@@ -0,0 +1,71 @@
+package treeviewpage
+
+import (
+	"log"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	datastar "github.com/starfederation/datastar/sdk/go"
+
+	"github.com/coreycole/datastarui/components/treeview"
+)
+
+// orgChart maps each manager to their direct reports, standing in for a database lookup
+var orgChart = map[string][]string{
+	"Ada Lovelace":      {"Grace Hopper", "Alan Turing", "Margaret Hamilton"},
+	"Grace Hopper":      {"Jean Bartik", "Frances Allen"},
+	"Alan Turing":       {"Claude Shannon"},
+	"Margaret Hamilton": {"Katherine Johnson", "Dorothy Vaughan", "Mary Jackson"},
+	"Claude Shannon":    {"John Tukey"},
+}
+
+// reports returns a manager's direct reports as nodes, lazy for those with reports of their own
+func reports(manager string) []treeview.Node {
+	var nodes []treeview.Node
+	for _, name := range orgChart[manager] {
+		nodes = append(nodes, treeview.Node{Value: name, Label: name, Lazy: len(orgChart[name]) > 0})
+	}
+	return nodes
+}
+
+// RegisterTreeViewPageHandlers registers all tree view demo route handlers
+func RegisterTreeViewPageHandlers(e *echo.Echo) {
+	// Lazy loading - streams in the children of an expanded node
+	e.GET("/treeview/tree-view-page/children", func(c echo.Context) error {
+		node, level := treeview.LoadParams(c.Request())
+
+		// Simulate a slow backend so the loading state is visible
+		time.Sleep(400 * time.Millisecond)
+
+		sse := datastar.NewSSE(c.Response().Writer, c.Request())
+		sse.MergeFragmentTempl(treeview.TreeGroup(treeview.TreeGroupProps{
+			ID:     "org-chart",
+			Parent: node,
+			Level:  level,
+			Nodes:  reports(node),
+		}))
+
+		log.Printf("Tree view loaded %d reports of %s", len(orgChart[node]), node)
+		return nil
+	})
+
+	// Permissions form - the tree submits its selected values as hidden inputs
+	e.POST("/treeview/tree-view-page/permissions", func(c echo.Context) error {
+		if err := c.Request().ParseMultipartForm(32 << 20); err != nil {
+			log.Printf("Error parsing form: %v", err)
+		}
+
+		permissions := c.Request().Form["permissions"]
+		log.Printf("Tree view form submitted - Permissions: %v", permissions)
+
+		summary := "none"
+		if len(permissions) > 0 {
+			summary = strings.Join(permissions, ", ")
+		}
+
+		sse := datastar.NewSSE(c.Response().Writer, c.Request())
+		sse.MergeFragmentTempl(permissionsResult(summary))
+		return nil
+	})
+}
@@ -0,0 +1,77 @@
+package uploadpage
+
+import (
+	"crypto/rand"
+	"fmt"
+	"log"
+	"mime/multipart"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	datastar "github.com/starfederation/datastar/sdk/go"
+
+	"github.com/coreycole/datastarui/components/upload"
+)
+
+// imagesUpload configures the image upload demo; the handler validates against the same props
+var imagesUpload = upload.UploadProps{
+	ID:       "images-upload",
+	URL:      "/upload/upload-page/images",
+	Accept:   "image/*",
+	MaxSize:  2 << 20,
+	MaxFiles: 4,
+	Multiple: true,
+}
+
+// attachmentsUpload configures the form upload demo
+var attachmentsUpload = upload.UploadProps{
+	ID:       "attachments-upload",
+	URL:      "/upload/upload-page/attachments",
+	Name:     "attachments",
+	Accept:   ".pdf,.txt,.md",
+	MaxSize:  5 << 20,
+	Multiple: true,
+}
+
+// discard stands in for storage: it reads nothing and returns a random ID for the file
+func discard(file *multipart.FileHeader) (string, error) {
+	b := make([]byte, 4)
+	rand.Read(b)
+	return fmt.Sprintf("file_%x", b), nil
+}
+
+// RegisterUploadPageHandlers registers all upload demo route handlers
+func RegisterUploadPageHandlers(e *echo.Echo) {
+	// Receive one file per request for each demo
+	for _, props := range []upload.UploadProps{imagesUpload, attachmentsUpload} {
+		e.POST(props.URL, func(c echo.Context) error {
+			result, err := upload.Handle(c.Response(), c.Request(), props, discard)
+			if result.Err != nil {
+				log.Printf("Upload rejected %q: %v", result.Name, result.Err)
+			} else {
+				log.Printf("Upload received %q (%d bytes) as %s", result.Name, result.Size, result.Value)
+			}
+			return err
+		})
+	}
+
+	// Attachments form - the upload submits the stored files' IDs as hidden inputs
+	e.POST("/upload/upload-page/submit", func(c echo.Context) error {
+		if err := c.Request().ParseMultipartForm(32 << 20); err != nil {
+			log.Printf("Error parsing form: %v", err)
+		}
+
+		subject := c.FormValue("subject")
+		attachments := c.Request().Form["attachments"]
+		log.Printf("Upload form submitted - Subject: %s, Attachments: %v", subject, attachments)
+
+		summary := "none"
+		if len(attachments) > 0 {
+			summary = strings.Join(attachments, ", ")
+		}
+
+		sse := datastar.NewSSE(c.Response().Writer, c.Request())
+		sse.MergeFragmentTempl(submitResult(subject, summary))
+		return nil
+	})
+}
@@ -97,3 +97,13 @@ func (sm *SignalManager) ConditionalMultiAction(condition string, actions ...str
 	}
 	return fmt.Sprintf("%s ? (%s) : void 0", condition, actionsStr)
 }
+
+// HiddenInputsJs returns an expression replacing the children of el with one hidden input named
+// name per value of signal, a value or an array of values; empty values are skipped.
+// Run it from data-on-load and data-on-signal-change to submit multiple values with a form.
+// Example: HiddenInputsJs("$tags.value", "tags") mirrors ["a", "b"] as two inputs named "tags"
+func HiddenInputsJs(signal, name string) string {
+	nameJSON, _ := json.Marshal(name)
+	return "el.replaceChildren(...[].concat(" + signal + ").filter(v => v !== '')" +
+		".map(v => Object.assign(document.createElement('input'), {type: 'hidden', name: " + string(nameJSON) + ", value: v})))"
+}